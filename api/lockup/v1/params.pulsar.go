@@ -0,0 +1,2539 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package lockupv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]string
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field LockTransferAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_max_lock_months                protoreflect.FieldDescriptor
+	fd_Params_min_lock_amount                protoreflect.FieldDescriptor
+	fd_Params_max_unlock_dates               protoreflect.FieldDescriptor
+	fd_Params_send_delegate_and_lock_enabled protoreflect.FieldDescriptor
+	fd_Params_early_unlock_enabled           protoreflect.FieldDescriptor
+	fd_Params_early_unlock_penalty_rate      protoreflect.FieldDescriptor
+	fd_Params_burn_early_unlock_penalty      protoreflect.FieldDescriptor
+	fd_Params_lock_transfer_enabled          protoreflect.FieldDescriptor
+	fd_Params_lock_transfer_allowlist        protoreflect.FieldDescriptor
+	fd_Params_lock_history_enabled           protoreflect.FieldDescriptor
+	fd_Params_lock_history_retention_days    protoreflect.FieldDescriptor
+	fd_Params_precompile_gas                 protoreflect.FieldDescriptor
+	fd_Params_lock_schedule_enabled          protoreflect.FieldDescriptor
+	fd_Params_max_lock_schedules             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_lockup_v1_params_proto_init()
+	md_Params = File_lockup_v1_params_proto.Messages().ByName("Params")
+	fd_Params_max_lock_months = md_Params.Fields().ByName("max_lock_months")
+	fd_Params_min_lock_amount = md_Params.Fields().ByName("min_lock_amount")
+	fd_Params_max_unlock_dates = md_Params.Fields().ByName("max_unlock_dates")
+	fd_Params_send_delegate_and_lock_enabled = md_Params.Fields().ByName("send_delegate_and_lock_enabled")
+	fd_Params_early_unlock_enabled = md_Params.Fields().ByName("early_unlock_enabled")
+	fd_Params_early_unlock_penalty_rate = md_Params.Fields().ByName("early_unlock_penalty_rate")
+	fd_Params_burn_early_unlock_penalty = md_Params.Fields().ByName("burn_early_unlock_penalty")
+	fd_Params_lock_transfer_enabled = md_Params.Fields().ByName("lock_transfer_enabled")
+	fd_Params_lock_transfer_allowlist = md_Params.Fields().ByName("lock_transfer_allowlist")
+	fd_Params_lock_history_enabled = md_Params.Fields().ByName("lock_history_enabled")
+	fd_Params_lock_history_retention_days = md_Params.Fields().ByName("lock_history_retention_days")
+	fd_Params_precompile_gas = md_Params.Fields().ByName("precompile_gas")
+	fd_Params_lock_schedule_enabled = md_Params.Fields().ByName("lock_schedule_enabled")
+	fd_Params_max_lock_schedules = md_Params.Fields().ByName("max_lock_schedules")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_lockup_v1_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxLockMonths != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLockMonths)
+		if !f(fd_Params_max_lock_months, value) {
+			return
+		}
+	}
+	if x.MinLockAmount != "" {
+		value := protoreflect.ValueOfString(x.MinLockAmount)
+		if !f(fd_Params_min_lock_amount, value) {
+			return
+		}
+	}
+	if x.MaxUnlockDates != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxUnlockDates)
+		if !f(fd_Params_max_unlock_dates, value) {
+			return
+		}
+	}
+	if x.SendDelegateAndLockEnabled != false {
+		value := protoreflect.ValueOfBool(x.SendDelegateAndLockEnabled)
+		if !f(fd_Params_send_delegate_and_lock_enabled, value) {
+			return
+		}
+	}
+	if x.EarlyUnlockEnabled != false {
+		value := protoreflect.ValueOfBool(x.EarlyUnlockEnabled)
+		if !f(fd_Params_early_unlock_enabled, value) {
+			return
+		}
+	}
+	if x.EarlyUnlockPenaltyRate != "" {
+		value := protoreflect.ValueOfString(x.EarlyUnlockPenaltyRate)
+		if !f(fd_Params_early_unlock_penalty_rate, value) {
+			return
+		}
+	}
+	if x.BurnEarlyUnlockPenalty != false {
+		value := protoreflect.ValueOfBool(x.BurnEarlyUnlockPenalty)
+		if !f(fd_Params_burn_early_unlock_penalty, value) {
+			return
+		}
+	}
+	if x.LockTransferEnabled != false {
+		value := protoreflect.ValueOfBool(x.LockTransferEnabled)
+		if !f(fd_Params_lock_transfer_enabled, value) {
+			return
+		}
+	}
+	if len(x.LockTransferAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.LockTransferAllowlist})
+		if !f(fd_Params_lock_transfer_allowlist, value) {
+			return
+		}
+	}
+	if x.LockHistoryEnabled != false {
+		value := protoreflect.ValueOfBool(x.LockHistoryEnabled)
+		if !f(fd_Params_lock_history_enabled, value) {
+			return
+		}
+	}
+	if x.LockHistoryRetentionDays != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockHistoryRetentionDays)
+		if !f(fd_Params_lock_history_retention_days, value) {
+			return
+		}
+	}
+	if x.PrecompileGas != nil {
+		value := protoreflect.ValueOfMessage(x.PrecompileGas.ProtoReflect())
+		if !f(fd_Params_precompile_gas, value) {
+			return
+		}
+	}
+	if x.LockScheduleEnabled != false {
+		value := protoreflect.ValueOfBool(x.LockScheduleEnabled)
+		if !f(fd_Params_lock_schedule_enabled, value) {
+			return
+		}
+	}
+	if x.MaxLockSchedules != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxLockSchedules)
+		if !f(fd_Params_max_lock_schedules, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "lockup.v1.Params.max_lock_months":
+		return x.MaxLockMonths != uint64(0)
+	case "lockup.v1.Params.min_lock_amount":
+		return x.MinLockAmount != ""
+	case "lockup.v1.Params.max_unlock_dates":
+		return x.MaxUnlockDates != uint64(0)
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		return x.SendDelegateAndLockEnabled != false
+	case "lockup.v1.Params.early_unlock_enabled":
+		return x.EarlyUnlockEnabled != false
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		return x.EarlyUnlockPenaltyRate != ""
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		return x.BurnEarlyUnlockPenalty != false
+	case "lockup.v1.Params.lock_transfer_enabled":
+		return x.LockTransferEnabled != false
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		return len(x.LockTransferAllowlist) != 0
+	case "lockup.v1.Params.lock_history_enabled":
+		return x.LockHistoryEnabled != false
+	case "lockup.v1.Params.lock_history_retention_days":
+		return x.LockHistoryRetentionDays != uint64(0)
+	case "lockup.v1.Params.precompile_gas":
+		return x.PrecompileGas != nil
+	case "lockup.v1.Params.lock_schedule_enabled":
+		return x.LockScheduleEnabled != false
+	case "lockup.v1.Params.max_lock_schedules":
+		return x.MaxLockSchedules != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "lockup.v1.Params.max_lock_months":
+		x.MaxLockMonths = uint64(0)
+	case "lockup.v1.Params.min_lock_amount":
+		x.MinLockAmount = ""
+	case "lockup.v1.Params.max_unlock_dates":
+		x.MaxUnlockDates = uint64(0)
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		x.SendDelegateAndLockEnabled = false
+	case "lockup.v1.Params.early_unlock_enabled":
+		x.EarlyUnlockEnabled = false
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		x.EarlyUnlockPenaltyRate = ""
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		x.BurnEarlyUnlockPenalty = false
+	case "lockup.v1.Params.lock_transfer_enabled":
+		x.LockTransferEnabled = false
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		x.LockTransferAllowlist = nil
+	case "lockup.v1.Params.lock_history_enabled":
+		x.LockHistoryEnabled = false
+	case "lockup.v1.Params.lock_history_retention_days":
+		x.LockHistoryRetentionDays = uint64(0)
+	case "lockup.v1.Params.precompile_gas":
+		x.PrecompileGas = nil
+	case "lockup.v1.Params.lock_schedule_enabled":
+		x.LockScheduleEnabled = false
+	case "lockup.v1.Params.max_lock_schedules":
+		x.MaxLockSchedules = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "lockup.v1.Params.max_lock_months":
+		value := x.MaxLockMonths
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.Params.min_lock_amount":
+		value := x.MinLockAmount
+		return protoreflect.ValueOfString(value)
+	case "lockup.v1.Params.max_unlock_dates":
+		value := x.MaxUnlockDates
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		value := x.SendDelegateAndLockEnabled
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.early_unlock_enabled":
+		value := x.EarlyUnlockEnabled
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		value := x.EarlyUnlockPenaltyRate
+		return protoreflect.ValueOfString(value)
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		value := x.BurnEarlyUnlockPenalty
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.lock_transfer_enabled":
+		value := x.LockTransferEnabled
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		if len(x.LockTransferAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.LockTransferAllowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "lockup.v1.Params.lock_history_enabled":
+		value := x.LockHistoryEnabled
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.lock_history_retention_days":
+		value := x.LockHistoryRetentionDays
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.Params.precompile_gas":
+		value := x.PrecompileGas
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "lockup.v1.Params.lock_schedule_enabled":
+		value := x.LockScheduleEnabled
+		return protoreflect.ValueOfBool(value)
+	case "lockup.v1.Params.max_lock_schedules":
+		value := x.MaxLockSchedules
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "lockup.v1.Params.max_lock_months":
+		x.MaxLockMonths = value.Uint()
+	case "lockup.v1.Params.min_lock_amount":
+		x.MinLockAmount = value.Interface().(string)
+	case "lockup.v1.Params.max_unlock_dates":
+		x.MaxUnlockDates = value.Uint()
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		x.SendDelegateAndLockEnabled = value.Bool()
+	case "lockup.v1.Params.early_unlock_enabled":
+		x.EarlyUnlockEnabled = value.Bool()
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		x.EarlyUnlockPenaltyRate = value.Interface().(string)
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		x.BurnEarlyUnlockPenalty = value.Bool()
+	case "lockup.v1.Params.lock_transfer_enabled":
+		x.LockTransferEnabled = value.Bool()
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.LockTransferAllowlist = *clv.list
+	case "lockup.v1.Params.lock_history_enabled":
+		x.LockHistoryEnabled = value.Bool()
+	case "lockup.v1.Params.lock_history_retention_days":
+		x.LockHistoryRetentionDays = value.Uint()
+	case "lockup.v1.Params.precompile_gas":
+		x.PrecompileGas = value.Message().Interface().(*PrecompileGas)
+	case "lockup.v1.Params.lock_schedule_enabled":
+		x.LockScheduleEnabled = value.Bool()
+	case "lockup.v1.Params.max_lock_schedules":
+		x.MaxLockSchedules = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		if x.LockTransferAllowlist == nil {
+			x.LockTransferAllowlist = []string{}
+		}
+		value := &_Params_9_list{list: &x.LockTransferAllowlist}
+		return protoreflect.ValueOfList(value)
+	case "lockup.v1.Params.precompile_gas":
+		if x.PrecompileGas == nil {
+			x.PrecompileGas = new(PrecompileGas)
+		}
+		return protoreflect.ValueOfMessage(x.PrecompileGas.ProtoReflect())
+	case "lockup.v1.Params.max_lock_months":
+		panic(fmt.Errorf("field max_lock_months of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.min_lock_amount":
+		panic(fmt.Errorf("field min_lock_amount of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.max_unlock_dates":
+		panic(fmt.Errorf("field max_unlock_dates of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		panic(fmt.Errorf("field send_delegate_and_lock_enabled of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.early_unlock_enabled":
+		panic(fmt.Errorf("field early_unlock_enabled of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		panic(fmt.Errorf("field early_unlock_penalty_rate of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		panic(fmt.Errorf("field burn_early_unlock_penalty of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.lock_transfer_enabled":
+		panic(fmt.Errorf("field lock_transfer_enabled of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.lock_history_enabled":
+		panic(fmt.Errorf("field lock_history_enabled of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.lock_history_retention_days":
+		panic(fmt.Errorf("field lock_history_retention_days of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.lock_schedule_enabled":
+		panic(fmt.Errorf("field lock_schedule_enabled of message lockup.v1.Params is not mutable"))
+	case "lockup.v1.Params.max_lock_schedules":
+		panic(fmt.Errorf("field max_lock_schedules of message lockup.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "lockup.v1.Params.max_lock_months":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.Params.min_lock_amount":
+		return protoreflect.ValueOfString("")
+	case "lockup.v1.Params.max_unlock_dates":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.Params.send_delegate_and_lock_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.early_unlock_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.early_unlock_penalty_rate":
+		return protoreflect.ValueOfString("")
+	case "lockup.v1.Params.burn_early_unlock_penalty":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.lock_transfer_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.lock_transfer_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "lockup.v1.Params.lock_history_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.lock_history_retention_days":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.Params.precompile_gas":
+		m := new(PrecompileGas)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "lockup.v1.Params.lock_schedule_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "lockup.v1.Params.max_lock_schedules":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.Params"))
+		}
+		panic(fmt.Errorf("message lockup.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in lockup.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxLockMonths != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLockMonths))
+		}
+		l = len(x.MinLockAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxUnlockDates != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxUnlockDates))
+		}
+		if x.SendDelegateAndLockEnabled {
+			n += 2
+		}
+		if x.EarlyUnlockEnabled {
+			n += 2
+		}
+		l = len(x.EarlyUnlockPenaltyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BurnEarlyUnlockPenalty {
+			n += 2
+		}
+		if x.LockTransferEnabled {
+			n += 2
+		}
+		if len(x.LockTransferAllowlist) > 0 {
+			for _, s := range x.LockTransferAllowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LockHistoryEnabled {
+			n += 2
+		}
+		if x.LockHistoryRetentionDays != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockHistoryRetentionDays))
+		}
+		if x.PrecompileGas != nil {
+			l = options.Size(x.PrecompileGas)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockScheduleEnabled {
+			n += 2
+		}
+		if x.MaxLockSchedules != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxLockSchedules))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxLockSchedules != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLockSchedules))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.LockScheduleEnabled {
+			i--
+			if x.LockScheduleEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.PrecompileGas != nil {
+			encoded, err := options.Marshal(x.PrecompileGas)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.LockHistoryRetentionDays != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockHistoryRetentionDays))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.LockHistoryEnabled {
+			i--
+			if x.LockHistoryEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.LockTransferAllowlist) > 0 {
+			for iNdEx := len(x.LockTransferAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.LockTransferAllowlist[iNdEx])
+				copy(dAtA[i:], x.LockTransferAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockTransferAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.LockTransferEnabled {
+			i--
+			if x.LockTransferEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.BurnEarlyUnlockPenalty {
+			i--
+			if x.BurnEarlyUnlockPenalty {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EarlyUnlockPenaltyRate) > 0 {
+			i -= len(x.EarlyUnlockPenaltyRate)
+			copy(dAtA[i:], x.EarlyUnlockPenaltyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EarlyUnlockPenaltyRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.EarlyUnlockEnabled {
+			i--
+			if x.EarlyUnlockEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SendDelegateAndLockEnabled {
+			i--
+			if x.SendDelegateAndLockEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxUnlockDates != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxUnlockDates))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.MinLockAmount) > 0 {
+			i -= len(x.MinLockAmount)
+			copy(dAtA[i:], x.MinLockAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLockAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxLockMonths != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxLockMonths))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLockMonths", wireType)
+				}
+				x.MaxLockMonths = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLockMonths |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLockAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockDates", wireType)
+				}
+				x.MaxUnlockDates = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxUnlockDates |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SendDelegateAndLockEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SendDelegateAndLockEnabled = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EarlyUnlockEnabled = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenaltyRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EarlyUnlockPenaltyRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnEarlyUnlockPenalty", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BurnEarlyUnlockPenalty = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTransferEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LockTransferEnabled = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockTransferAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockTransferAllowlist = append(x.LockTransferAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockHistoryEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LockHistoryEnabled = bool(v != 0)
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockHistoryRetentionDays", wireType)
+				}
+				x.LockHistoryRetentionDays = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockHistoryRetentionDays |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecompileGas", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PrecompileGas == nil {
+					x.PrecompileGas = &PrecompileGas{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrecompileGas); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockScheduleEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LockScheduleEnabled = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxLockSchedules", wireType)
+				}
+				x.MaxLockSchedules = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxLockSchedules |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PrecompileGas                                  protoreflect.MessageDescriptor
+	fd_PrecompileGas_lock_gas                         protoreflect.FieldDescriptor
+	fd_PrecompileGas_extend_gas                       protoreflect.FieldDescriptor
+	fd_PrecompileGas_per_extension_gas                protoreflect.FieldDescriptor
+	fd_PrecompileGas_send_delegate_and_lock_gas       protoreflect.FieldDescriptor
+	fd_PrecompileGas_multi_send_delegate_and_lock_gas protoreflect.FieldDescriptor
+	fd_PrecompileGas_per_output_gas                   protoreflect.FieldDescriptor
+	fd_PrecompileGas_unlock_early_gas                 protoreflect.FieldDescriptor
+	fd_PrecompileGas_allowance_gas                    protoreflect.FieldDescriptor
+	fd_PrecompileGas_query_gas                        protoreflect.FieldDescriptor
+	fd_PrecompileGas_per_result_gas                   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_lockup_v1_params_proto_init()
+	md_PrecompileGas = File_lockup_v1_params_proto.Messages().ByName("PrecompileGas")
+	fd_PrecompileGas_lock_gas = md_PrecompileGas.Fields().ByName("lock_gas")
+	fd_PrecompileGas_extend_gas = md_PrecompileGas.Fields().ByName("extend_gas")
+	fd_PrecompileGas_per_extension_gas = md_PrecompileGas.Fields().ByName("per_extension_gas")
+	fd_PrecompileGas_send_delegate_and_lock_gas = md_PrecompileGas.Fields().ByName("send_delegate_and_lock_gas")
+	fd_PrecompileGas_multi_send_delegate_and_lock_gas = md_PrecompileGas.Fields().ByName("multi_send_delegate_and_lock_gas")
+	fd_PrecompileGas_per_output_gas = md_PrecompileGas.Fields().ByName("per_output_gas")
+	fd_PrecompileGas_unlock_early_gas = md_PrecompileGas.Fields().ByName("unlock_early_gas")
+	fd_PrecompileGas_allowance_gas = md_PrecompileGas.Fields().ByName("allowance_gas")
+	fd_PrecompileGas_query_gas = md_PrecompileGas.Fields().ByName("query_gas")
+	fd_PrecompileGas_per_result_gas = md_PrecompileGas.Fields().ByName("per_result_gas")
+}
+
+var _ protoreflect.Message = (*fastReflection_PrecompileGas)(nil)
+
+type fastReflection_PrecompileGas PrecompileGas
+
+func (x *PrecompileGas) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PrecompileGas)(x)
+}
+
+func (x *PrecompileGas) slowProtoReflect() protoreflect.Message {
+	mi := &file_lockup_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PrecompileGas_messageType fastReflection_PrecompileGas_messageType
+var _ protoreflect.MessageType = fastReflection_PrecompileGas_messageType{}
+
+type fastReflection_PrecompileGas_messageType struct{}
+
+func (x fastReflection_PrecompileGas_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PrecompileGas)(nil)
+}
+func (x fastReflection_PrecompileGas_messageType) New() protoreflect.Message {
+	return new(fastReflection_PrecompileGas)
+}
+func (x fastReflection_PrecompileGas_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileGas
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PrecompileGas) Descriptor() protoreflect.MessageDescriptor {
+	return md_PrecompileGas
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PrecompileGas) Type() protoreflect.MessageType {
+	return _fastReflection_PrecompileGas_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PrecompileGas) New() protoreflect.Message {
+	return new(fastReflection_PrecompileGas)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PrecompileGas) Interface() protoreflect.ProtoMessage {
+	return (*PrecompileGas)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PrecompileGas) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockGas)
+		if !f(fd_PrecompileGas_lock_gas, value) {
+			return
+		}
+	}
+	if x.ExtendGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExtendGas)
+		if !f(fd_PrecompileGas_extend_gas, value) {
+			return
+		}
+	}
+	if x.PerExtensionGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerExtensionGas)
+		if !f(fd_PrecompileGas_per_extension_gas, value) {
+			return
+		}
+	}
+	if x.SendDelegateAndLockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SendDelegateAndLockGas)
+		if !f(fd_PrecompileGas_send_delegate_and_lock_gas, value) {
+			return
+		}
+	}
+	if x.MultiSendDelegateAndLockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MultiSendDelegateAndLockGas)
+		if !f(fd_PrecompileGas_multi_send_delegate_and_lock_gas, value) {
+			return
+		}
+	}
+	if x.PerOutputGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerOutputGas)
+		if !f(fd_PrecompileGas_per_output_gas, value) {
+			return
+		}
+	}
+	if x.UnlockEarlyGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnlockEarlyGas)
+		if !f(fd_PrecompileGas_unlock_early_gas, value) {
+			return
+		}
+	}
+	if x.AllowanceGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AllowanceGas)
+		if !f(fd_PrecompileGas_allowance_gas, value) {
+			return
+		}
+	}
+	if x.QueryGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueryGas)
+		if !f(fd_PrecompileGas_query_gas, value) {
+			return
+		}
+	}
+	if x.PerResultGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PerResultGas)
+		if !f(fd_PrecompileGas_per_result_gas, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PrecompileGas) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		return x.LockGas != uint64(0)
+	case "lockup.v1.PrecompileGas.extend_gas":
+		return x.ExtendGas != uint64(0)
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		return x.PerExtensionGas != uint64(0)
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		return x.SendDelegateAndLockGas != uint64(0)
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		return x.MultiSendDelegateAndLockGas != uint64(0)
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		return x.PerOutputGas != uint64(0)
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		return x.UnlockEarlyGas != uint64(0)
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		return x.AllowanceGas != uint64(0)
+	case "lockup.v1.PrecompileGas.query_gas":
+		return x.QueryGas != uint64(0)
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		return x.PerResultGas != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileGas) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		x.LockGas = uint64(0)
+	case "lockup.v1.PrecompileGas.extend_gas":
+		x.ExtendGas = uint64(0)
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		x.PerExtensionGas = uint64(0)
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		x.SendDelegateAndLockGas = uint64(0)
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		x.MultiSendDelegateAndLockGas = uint64(0)
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		x.PerOutputGas = uint64(0)
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		x.UnlockEarlyGas = uint64(0)
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		x.AllowanceGas = uint64(0)
+	case "lockup.v1.PrecompileGas.query_gas":
+		x.QueryGas = uint64(0)
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		x.PerResultGas = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PrecompileGas) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		value := x.LockGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.extend_gas":
+		value := x.ExtendGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		value := x.PerExtensionGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		value := x.SendDelegateAndLockGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		value := x.MultiSendDelegateAndLockGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		value := x.PerOutputGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		value := x.UnlockEarlyGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		value := x.AllowanceGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.query_gas":
+		value := x.QueryGas
+		return protoreflect.ValueOfUint64(value)
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		value := x.PerResultGas
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileGas) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		x.LockGas = value.Uint()
+	case "lockup.v1.PrecompileGas.extend_gas":
+		x.ExtendGas = value.Uint()
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		x.PerExtensionGas = value.Uint()
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		x.SendDelegateAndLockGas = value.Uint()
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		x.MultiSendDelegateAndLockGas = value.Uint()
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		x.PerOutputGas = value.Uint()
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		x.UnlockEarlyGas = value.Uint()
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		x.AllowanceGas = value.Uint()
+	case "lockup.v1.PrecompileGas.query_gas":
+		x.QueryGas = value.Uint()
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		x.PerResultGas = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileGas) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		panic(fmt.Errorf("field lock_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.extend_gas":
+		panic(fmt.Errorf("field extend_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		panic(fmt.Errorf("field per_extension_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		panic(fmt.Errorf("field send_delegate_and_lock_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		panic(fmt.Errorf("field multi_send_delegate_and_lock_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		panic(fmt.Errorf("field per_output_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		panic(fmt.Errorf("field unlock_early_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		panic(fmt.Errorf("field allowance_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.query_gas":
+		panic(fmt.Errorf("field query_gas of message lockup.v1.PrecompileGas is not mutable"))
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		panic(fmt.Errorf("field per_result_gas of message lockup.v1.PrecompileGas is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PrecompileGas) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "lockup.v1.PrecompileGas.lock_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.extend_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.per_extension_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.send_delegate_and_lock_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.multi_send_delegate_and_lock_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.per_output_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.unlock_early_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.allowance_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.query_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "lockup.v1.PrecompileGas.per_result_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: lockup.v1.PrecompileGas"))
+		}
+		panic(fmt.Errorf("message lockup.v1.PrecompileGas does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PrecompileGas) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in lockup.v1.PrecompileGas", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PrecompileGas) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PrecompileGas) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PrecompileGas) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PrecompileGas) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PrecompileGas)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.LockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockGas))
+		}
+		if x.ExtendGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExtendGas))
+		}
+		if x.PerExtensionGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerExtensionGas))
+		}
+		if x.SendDelegateAndLockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.SendDelegateAndLockGas))
+		}
+		if x.MultiSendDelegateAndLockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MultiSendDelegateAndLockGas))
+		}
+		if x.PerOutputGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerOutputGas))
+		}
+		if x.UnlockEarlyGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnlockEarlyGas))
+		}
+		if x.AllowanceGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.AllowanceGas))
+		}
+		if x.QueryGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueryGas))
+		}
+		if x.PerResultGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.PerResultGas))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileGas)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PerResultGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerResultGas))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.QueryGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueryGas))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.AllowanceGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AllowanceGas))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.UnlockEarlyGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnlockEarlyGas))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.PerOutputGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerOutputGas))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MultiSendDelegateAndLockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MultiSendDelegateAndLockGas))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SendDelegateAndLockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SendDelegateAndLockGas))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PerExtensionGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PerExtensionGas))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExtendGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExtendGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.LockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockGas))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PrecompileGas)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileGas: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PrecompileGas: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockGas", wireType)
+				}
+				x.LockGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtendGas", wireType)
+				}
+				x.ExtendGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExtendGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerExtensionGas", wireType)
+				}
+				x.PerExtensionGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerExtensionGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SendDelegateAndLockGas", wireType)
+				}
+				x.SendDelegateAndLockGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SendDelegateAndLockGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MultiSendDelegateAndLockGas", wireType)
+				}
+				x.MultiSendDelegateAndLockGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MultiSendDelegateAndLockGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerOutputGas", wireType)
+				}
+				x.PerOutputGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerOutputGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnlockEarlyGas", wireType)
+				}
+				x.UnlockEarlyGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnlockEarlyGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowanceGas", wireType)
+				}
+				x.AllowanceGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AllowanceGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueryGas", wireType)
+				}
+				x.QueryGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueryGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PerResultGas", wireType)
+				}
+				x.PerResultGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PerResultGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: lockup/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the set of module parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_lock_months is the furthest an unlock date may be set from the
+	// current block time, in calendar months.
+	MaxLockMonths uint64 `protobuf:"varint,1,opt,name=max_lock_months,json=maxLockMonths,proto3" json:"max_lock_months,omitempty"`
+	// min_lock_amount is the smallest amount accepted by a single lock.
+	MinLockAmount string `protobuf:"bytes,2,opt,name=min_lock_amount,json=minLockAmount,proto3" json:"min_lock_amount,omitempty"`
+	// max_unlock_dates is the maximum number of distinct unlock dates an address
+	// may hold at once. Zero means unlimited.
+	MaxUnlockDates uint64 `protobuf:"varint,3,opt,name=max_unlock_dates,json=maxUnlockDates,proto3" json:"max_unlock_dates,omitempty"`
+	// send_delegate_and_lock_enabled toggles MsgSendDelegateAndLock and
+	// MsgMultiSendDelegateAndLock.
+	SendDelegateAndLockEnabled bool `protobuf:"varint,4,opt,name=send_delegate_and_lock_enabled,json=sendDelegateAndLockEnabled,proto3" json:"send_delegate_and_lock_enabled,omitempty"`
+	// early_unlock_enabled toggles MsgUnlockEarly.
+	EarlyUnlockEnabled bool `protobuf:"varint,5,opt,name=early_unlock_enabled,json=earlyUnlockEnabled,proto3" json:"early_unlock_enabled,omitempty"`
+	// early_unlock_penalty_rate is the fraction of an early unlocked amount
+	// charged as a penalty, between 0 and 1.
+	EarlyUnlockPenaltyRate string `protobuf:"bytes,6,opt,name=early_unlock_penalty_rate,json=earlyUnlockPenaltyRate,proto3" json:"early_unlock_penalty_rate,omitempty"`
+	// burn_early_unlock_penalty burns the early unlock penalty instead of
+	// sending it to the community pool.
+	BurnEarlyUnlockPenalty bool `protobuf:"varint,7,opt,name=burn_early_unlock_penalty,json=burnEarlyUnlockPenalty,proto3" json:"burn_early_unlock_penalty,omitempty"`
+	// lock_transfer_enabled toggles MsgTransferLock.
+	LockTransferEnabled bool `protobuf:"varint,8,opt,name=lock_transfer_enabled,json=lockTransferEnabled,proto3" json:"lock_transfer_enabled,omitempty"`
+	// lock_transfer_allowlist restricts MsgTransferLock to the listed sender
+	// addresses. An empty list lets every address transfer its locks.
+	LockTransferAllowlist []string `protobuf:"bytes,9,rep,name=lock_transfer_allowlist,json=lockTransferAllowlist,proto3" json:"lock_transfer_allowlist,omitempty"`
+	// lock_history_enabled records the lock changes of every address in the
+	// lock history.
+	LockHistoryEnabled bool `protobuf:"varint,10,opt,name=lock_history_enabled,json=lockHistoryEnabled,proto3" json:"lock_history_enabled,omitempty"`
+	// lock_history_retention_days is how long lock history entries are kept.
+	// Zero keeps them forever.
+	LockHistoryRetentionDays uint64 `protobuf:"varint,11,opt,name=lock_history_retention_days,json=lockHistoryRetentionDays,proto3" json:"lock_history_retention_days,omitempty"`
+	// precompile_gas is the gas schedule of the lockup precompile.
+	PrecompileGas *PrecompileGas `protobuf:"bytes,12,opt,name=precompile_gas,json=precompileGas,proto3" json:"precompile_gas,omitempty"`
+	// lock_schedule_enabled toggles MsgLockWithSchedule.
+	LockScheduleEnabled bool `protobuf:"varint,13,opt,name=lock_schedule_enabled,json=lockScheduleEnabled,proto3" json:"lock_schedule_enabled,omitempty"`
+	// max_lock_schedules is the maximum number of lock schedules an address may
+	// hold at once. Every schedule of an address is read whenever its locks are
+	// checked, so it must be greater than zero.
+	MaxLockSchedules uint64 `protobuf:"varint,14,opt,name=max_lock_schedules,json=maxLockSchedules,proto3" json:"max_lock_schedules,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lockup_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_lockup_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMaxLockMonths() uint64 {
+	if x != nil {
+		return x.MaxLockMonths
+	}
+	return 0
+}
+
+func (x *Params) GetMinLockAmount() string {
+	if x != nil {
+		return x.MinLockAmount
+	}
+	return ""
+}
+
+func (x *Params) GetMaxUnlockDates() uint64 {
+	if x != nil {
+		return x.MaxUnlockDates
+	}
+	return 0
+}
+
+func (x *Params) GetSendDelegateAndLockEnabled() bool {
+	if x != nil {
+		return x.SendDelegateAndLockEnabled
+	}
+	return false
+}
+
+func (x *Params) GetEarlyUnlockEnabled() bool {
+	if x != nil {
+		return x.EarlyUnlockEnabled
+	}
+	return false
+}
+
+func (x *Params) GetEarlyUnlockPenaltyRate() string {
+	if x != nil {
+		return x.EarlyUnlockPenaltyRate
+	}
+	return ""
+}
+
+func (x *Params) GetBurnEarlyUnlockPenalty() bool {
+	if x != nil {
+		return x.BurnEarlyUnlockPenalty
+	}
+	return false
+}
+
+func (x *Params) GetLockTransferEnabled() bool {
+	if x != nil {
+		return x.LockTransferEnabled
+	}
+	return false
+}
+
+func (x *Params) GetLockTransferAllowlist() []string {
+	if x != nil {
+		return x.LockTransferAllowlist
+	}
+	return nil
+}
+
+func (x *Params) GetLockHistoryEnabled() bool {
+	if x != nil {
+		return x.LockHistoryEnabled
+	}
+	return false
+}
+
+func (x *Params) GetLockHistoryRetentionDays() uint64 {
+	if x != nil {
+		return x.LockHistoryRetentionDays
+	}
+	return 0
+}
+
+func (x *Params) GetPrecompileGas() *PrecompileGas {
+	if x != nil {
+		return x.PrecompileGas
+	}
+	return nil
+}
+
+func (x *Params) GetLockScheduleEnabled() bool {
+	if x != nil {
+		return x.LockScheduleEnabled
+	}
+	return false
+}
+
+func (x *Params) GetMaxLockSchedules() uint64 {
+	if x != nil {
+		return x.MaxLockSchedules
+	}
+	return 0
+}
+
+// PrecompileGas is the gas the lockup precompile charges on top of the flat
+// cost of a call, so that the cost of a call grows with the state it changes
+// or reads. A zero amount charges nothing extra.
+type PrecompileGas struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lock_gas is charged by lock and lockUntil.
+	LockGas uint64 `protobuf:"varint,1,opt,name=lock_gas,json=lockGas,proto3" json:"lock_gas,omitempty"`
+	// extend_gas is charged by extend and extendTo.
+	ExtendGas uint64 `protobuf:"varint,2,opt,name=extend_gas,json=extendGas,proto3" json:"extend_gas,omitempty"`
+	// per_extension_gas is charged by extend and extendTo for each extension.
+	PerExtensionGas uint64 `protobuf:"varint,3,opt,name=per_extension_gas,json=perExtensionGas,proto3" json:"per_extension_gas,omitempty"`
+	// send_delegate_and_lock_gas is charged by sendDelegateAndLock and
+	// sendDelegateAndLockUntil.
+	SendDelegateAndLockGas uint64 `protobuf:"varint,4,opt,name=send_delegate_and_lock_gas,json=sendDelegateAndLockGas,proto3" json:"send_delegate_and_lock_gas,omitempty"`
+	// multi_send_delegate_and_lock_gas is charged by multiSendDelegateAndLock.
+	MultiSendDelegateAndLockGas uint64 `protobuf:"varint,5,opt,name=multi_send_delegate_and_lock_gas,json=multiSendDelegateAndLockGas,proto3" json:"multi_send_delegate_and_lock_gas,omitempty"`
+	// per_output_gas is charged by multiSendDelegateAndLock for each output.
+	PerOutputGas uint64 `protobuf:"varint,6,opt,name=per_output_gas,json=perOutputGas,proto3" json:"per_output_gas,omitempty"`
+	// unlock_early_gas is charged by unlockEarly.
+	UnlockEarlyGas uint64 `protobuf:"varint,7,opt,name=unlock_early_gas,json=unlockEarlyGas,proto3" json:"unlock_early_gas,omitempty"`
+	// allowance_gas is charged by approve and revoke.
+	AllowanceGas uint64 `protobuf:"varint,9,opt,name=allowance_gas,json=allowanceGas,proto3" json:"allowance_gas,omitempty"`
+	// query_gas is charged by every query.
+	QueryGas uint64 `protobuf:"varint,10,opt,name=query_gas,json=queryGas,proto3" json:"query_gas,omitempty"`
+	// per_result_gas is charged by queries for each lock they return.
+	PerResultGas uint64 `protobuf:"varint,11,opt,name=per_result_gas,json=perResultGas,proto3" json:"per_result_gas,omitempty"`
+}
+
+func (x *PrecompileGas) Reset() {
+	*x = PrecompileGas{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lockup_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecompileGas) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecompileGas) ProtoMessage() {}
+
+// Deprecated: Use PrecompileGas.ProtoReflect.Descriptor instead.
+func (*PrecompileGas) Descriptor() ([]byte, []int) {
+	return file_lockup_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrecompileGas) GetLockGas() uint64 {
+	if x != nil {
+		return x.LockGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetExtendGas() uint64 {
+	if x != nil {
+		return x.ExtendGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetPerExtensionGas() uint64 {
+	if x != nil {
+		return x.PerExtensionGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetSendDelegateAndLockGas() uint64 {
+	if x != nil {
+		return x.SendDelegateAndLockGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetMultiSendDelegateAndLockGas() uint64 {
+	if x != nil {
+		return x.MultiSendDelegateAndLockGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetPerOutputGas() uint64 {
+	if x != nil {
+		return x.PerOutputGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetUnlockEarlyGas() uint64 {
+	if x != nil {
+		return x.UnlockEarlyGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetAllowanceGas() uint64 {
+	if x != nil {
+		return x.AllowanceGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetQueryGas() uint64 {
+	if x != nil {
+		return x.QueryGas
+	}
+	return 0
+}
+
+func (x *PrecompileGas) GetPerResultGas() uint64 {
+	if x != nil {
+		return x.PerResultGas
+	}
+	return 0
+}
+
+var File_lockup_v1_params_proto protoreflect.FileDescriptor
+
+var file_lockup_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x07, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x58, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x42,
+	0x0a, 0x1e, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f,
+	0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x71, 0x0a, 0x19, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x16, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x62, 0x75, 0x72, 0x6e, 0x5f,
+	0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x62, 0x75, 0x72, 0x6e,
+	0x45, 0x61, 0x72, 0x6c, 0x79, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x17, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x18, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x47, 0x61, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x69,
+	0x6c, 0x65, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x1c, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x13, 0x74, 0x73, 0x63, 0x2f, 0x78, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xcf, 0x03, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x47, 0x61, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x47,
+	0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x67, 0x61, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x47, 0x61,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x61, 0x73, 0x12, 0x3a, 0x0a,
+	0x1a, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41,
+	0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x12, 0x45, 0x0a, 0x20, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x1b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x4c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x47, 0x61, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x47, 0x61, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x67, 0x61,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x47, 0x61, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x67,
+	0x61, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x61, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x67, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x4a, 0x04,
+	0x08, 0x08, 0x10, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x42, 0x9d, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x6d, 0x61, 0x72,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x73, 0x63, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x4c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x15, 0x4c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lockup_v1_params_proto_rawDescOnce sync.Once
+	file_lockup_v1_params_proto_rawDescData = file_lockup_v1_params_proto_rawDesc
+)
+
+func file_lockup_v1_params_proto_rawDescGZIP() []byte {
+	file_lockup_v1_params_proto_rawDescOnce.Do(func() {
+		file_lockup_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_lockup_v1_params_proto_rawDescData)
+	})
+	return file_lockup_v1_params_proto_rawDescData
+}
+
+var file_lockup_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_lockup_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),        // 0: lockup.v1.Params
+	(*PrecompileGas)(nil), // 1: lockup.v1.PrecompileGas
+}
+var file_lockup_v1_params_proto_depIdxs = []int32{
+	1, // 0: lockup.v1.Params.precompile_gas:type_name -> lockup.v1.PrecompileGas
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_lockup_v1_params_proto_init() }
+func file_lockup_v1_params_proto_init() {
+	if File_lockup_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lockup_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lockup_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecompileGas); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_lockup_v1_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lockup_v1_params_proto_goTypes,
+		DependencyIndexes: file_lockup_v1_params_proto_depIdxs,
+		MessageInfos:      file_lockup_v1_params_proto_msgTypes,
+	}.Build()
+	File_lockup_v1_params_proto = out.File
+	file_lockup_v1_params_proto_rawDesc = nil
+	file_lockup_v1_params_proto_goTypes = nil
+	file_lockup_v1_params_proto_depIdxs = nil
+}
@@ -19,24 +19,70 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_ActiveLocks_FullMethodName       = "/lockup.v1.Query/ActiveLocks"
-	Query_TotalLockedAmount_FullMethodName = "/lockup.v1.Query/TotalLockedAmount"
-	Query_AccountLocks_FullMethodName      = "/lockup.v1.Query/AccountLocks"
-	Query_Locks_FullMethodName             = "/lockup.v1.Query/Locks"
+	Query_Params_FullMethodName                  = "/lockup.v1.Query/Params"
+	Query_ActiveLocks_FullMethodName             = "/lockup.v1.Query/ActiveLocks"
+	Query_TotalLockedAmount_FullMethodName       = "/lockup.v1.Query/TotalLockedAmount"
+	Query_UnlockSchedule_FullMethodName          = "/lockup.v1.Query/UnlockSchedule"
+	Query_UnlockScheduleByAddress_FullMethodName = "/lockup.v1.Query/UnlockScheduleByAddress"
+	Query_AccountLocks_FullMethodName            = "/lockup.v1.Query/AccountLocks"
+	Query_AccountLocksBatch_FullMethodName       = "/lockup.v1.Query/AccountLocksBatch"
+	Query_Locks_FullMethodName                   = "/lockup.v1.Query/Locks"
+	Query_SlashAdjustments_FullMethodName        = "/lockup.v1.Query/SlashAdjustments"
+	Query_LockStatus_FullMethodName              = "/lockup.v1.Query/LockStatus"
+	Query_LocksByValidator_FullMethodName        = "/lockup.v1.Query/LocksByValidator"
+	Query_LockHistory_FullMethodName             = "/lockup.v1.Query/LockHistory"
+	Query_SpendableBondBalance_FullMethodName    = "/lockup.v1.Query/SpendableBondBalance"
+	Query_MaxUndelegatable_FullMethodName        = "/lockup.v1.Query/MaxUndelegatable"
+	Query_LockAllowance_FullMethodName           = "/lockup.v1.Query/LockAllowance"
+	Query_LockTransferAcceptance_FullMethodName  = "/lockup.v1.Query/LockTransferAcceptance"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params queries all parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// ActiveLocks queries all active locks with an unlock date in the future.
 	ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error)
 	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
 	TotalLockedAmount(ctx context.Context, in *QueryTotalLockedAmountRequest, opts ...grpc.CallOption) (*QueryTotalLockedAmountResponse, error)
+	// UnlockSchedule queries the amount unlocking across all accounts per
+	// day, week or month.
+	UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error)
+	// UnlockScheduleByAddress queries the amount of an address unlocking per
+	// day, week or month.
+	UnlockScheduleByAddress(ctx context.Context, in *QueryUnlockScheduleByAddressRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleByAddressResponse, error)
 	// AccountLocks queries active locks for multiple addresses.
 	AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error)
+	// AccountLocksBatch queries active locks for multiple addresses sent in the
+	// request body, for batches too long for the AccountLocks URL.
+	AccountLocksBatch(ctx context.Context, in *QueryAccountLocksBatchRequest, opts ...grpc.CallOption) (*QueryAccountLocksBatchResponse, error)
 	// Locks queries active locks for an address.
 	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
+	// SlashAdjustments queries the lock amount removed from an address because
+	// of validator slashes.
+	SlashAdjustments(ctx context.Context, in *QuerySlashAdjustmentsRequest, opts ...grpc.CallOption) (*QuerySlashAdjustmentsResponse, error)
+	// LockStatus queries the amount an address has locked now, including its
+	// release schedules, and the next time part of it unlocks.
+	LockStatus(ctx context.Context, in *QueryLockStatusRequest, opts ...grpc.CallOption) (*QueryLockStatusResponse, error)
+	// LocksByValidator queries the active locks bound to a validator.
+	LocksByValidator(ctx context.Context, in *QueryLocksByValidatorRequest, opts ...grpc.CallOption) (*QueryLocksByValidatorResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest
+	// first.
+	LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error)
+	// SpendableBondBalance queries the bond denom balance an address can send,
+	// given the part of its locks not covered by delegations.
+	SpendableBondBalance(ctx context.Context, in *QuerySpendableBondBalanceRequest, opts ...grpc.CallOption) (*QuerySpendableBondBalanceResponse, error)
+	// MaxUndelegatable queries the amount an address can undelegate from a
+	// validator without its delegations dropping below its locks.
+	MaxUndelegatable(ctx context.Context, in *QueryMaxUndelegatableRequest, opts ...grpc.CallOption) (*QueryMaxUndelegatableResponse, error)
+	// LockAllowance queries the allowance of spender to lock on behalf of
+	// owner.
+	LockAllowance(ctx context.Context, in *QueryLockAllowanceRequest, opts ...grpc.CallOption) (*QueryLockAllowanceResponse, error)
+	// LockTransferAcceptance queries whether address accepts the locks
+	// from_address transfers to it.
+	LockTransferAcceptance(ctx context.Context, in *QueryLockTransferAcceptanceRequest, opts ...grpc.CallOption) (*QueryLockTransferAcceptanceResponse, error)
 }
 
 type queryClient struct {
@@ -47,6 +93,15 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ActiveLocks(ctx context.Context, in *QueryActiveLocksRequest, opts ...grpc.CallOption) (*QueryActiveLocksResponse, error) {
 	out := new(QueryActiveLocksResponse)
 	err := c.cc.Invoke(ctx, Query_ActiveLocks_FullMethodName, in, out, opts...)
@@ -65,6 +120,24 @@ func (c *queryClient) TotalLockedAmount(ctx context.Context, in *QueryTotalLocke
 	return out, nil
 }
 
+func (c *queryClient) UnlockSchedule(ctx context.Context, in *QueryUnlockScheduleRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleResponse, error) {
+	out := new(QueryUnlockScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnlockScheduleByAddress(ctx context.Context, in *QueryUnlockScheduleByAddressRequest, opts ...grpc.CallOption) (*QueryUnlockScheduleByAddressResponse, error) {
+	out := new(QueryUnlockScheduleByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_UnlockScheduleByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksRequest, opts ...grpc.CallOption) (*QueryAccountLocksResponse, error) {
 	out := new(QueryAccountLocksResponse)
 	err := c.cc.Invoke(ctx, Query_AccountLocks_FullMethodName, in, out, opts...)
@@ -74,6 +147,15 @@ func (c *queryClient) AccountLocks(ctx context.Context, in *QueryAccountLocksReq
 	return out, nil
 }
 
+func (c *queryClient) AccountLocksBatch(ctx context.Context, in *QueryAccountLocksBatchRequest, opts ...grpc.CallOption) (*QueryAccountLocksBatchResponse, error) {
+	out := new(QueryAccountLocksBatchResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLocksBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
 	out := new(QueryLocksResponse)
 	err := c.cc.Invoke(ctx, Query_Locks_FullMethodName, in, out, opts...)
@@ -83,18 +165,124 @@ func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) SlashAdjustments(ctx context.Context, in *QuerySlashAdjustmentsRequest, opts ...grpc.CallOption) (*QuerySlashAdjustmentsResponse, error) {
+	out := new(QuerySlashAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashAdjustments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockStatus(ctx context.Context, in *QueryLockStatusRequest, opts ...grpc.CallOption) (*QueryLockStatusResponse, error) {
+	out := new(QueryLockStatusResponse)
+	err := c.cc.Invoke(ctx, Query_LockStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LocksByValidator(ctx context.Context, in *QueryLocksByValidatorRequest, opts ...grpc.CallOption) (*QueryLocksByValidatorResponse, error) {
+	out := new(QueryLocksByValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_LocksByValidator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockHistory(ctx context.Context, in *QueryLockHistoryRequest, opts ...grpc.CallOption) (*QueryLockHistoryResponse, error) {
+	out := new(QueryLockHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_LockHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendableBondBalance(ctx context.Context, in *QuerySpendableBondBalanceRequest, opts ...grpc.CallOption) (*QuerySpendableBondBalanceResponse, error) {
+	out := new(QuerySpendableBondBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_SpendableBondBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxUndelegatable(ctx context.Context, in *QueryMaxUndelegatableRequest, opts ...grpc.CallOption) (*QueryMaxUndelegatableResponse, error) {
+	out := new(QueryMaxUndelegatableResponse)
+	err := c.cc.Invoke(ctx, Query_MaxUndelegatable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockAllowance(ctx context.Context, in *QueryLockAllowanceRequest, opts ...grpc.CallOption) (*QueryLockAllowanceResponse, error) {
+	out := new(QueryLockAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_LockAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockTransferAcceptance(ctx context.Context, in *QueryLockTransferAcceptanceRequest, opts ...grpc.CallOption) (*QueryLockTransferAcceptanceResponse, error) {
+	out := new(QueryLockTransferAcceptanceResponse)
+	err := c.cc.Invoke(ctx, Query_LockTransferAcceptance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
+	// Params queries all parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// ActiveLocks queries all active locks with an unlock date in the future.
 	ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error)
 	// TotalLockedAmount queries the total amount of tokens locked across all accounts.
 	TotalLockedAmount(context.Context, *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error)
+	// UnlockSchedule queries the amount unlocking across all accounts per
+	// day, week or month.
+	UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error)
+	// UnlockScheduleByAddress queries the amount of an address unlocking per
+	// day, week or month.
+	UnlockScheduleByAddress(context.Context, *QueryUnlockScheduleByAddressRequest) (*QueryUnlockScheduleByAddressResponse, error)
 	// AccountLocks queries active locks for multiple addresses.
 	AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error)
+	// AccountLocksBatch queries active locks for multiple addresses sent in the
+	// request body, for batches too long for the AccountLocks URL.
+	AccountLocksBatch(context.Context, *QueryAccountLocksBatchRequest) (*QueryAccountLocksBatchResponse, error)
 	// Locks queries active locks for an address.
 	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
+	// SlashAdjustments queries the lock amount removed from an address because
+	// of validator slashes.
+	SlashAdjustments(context.Context, *QuerySlashAdjustmentsRequest) (*QuerySlashAdjustmentsResponse, error)
+	// LockStatus queries the amount an address has locked now, including its
+	// release schedules, and the next time part of it unlocks.
+	LockStatus(context.Context, *QueryLockStatusRequest) (*QueryLockStatusResponse, error)
+	// LocksByValidator queries the active locks bound to a validator.
+	LocksByValidator(context.Context, *QueryLocksByValidatorRequest) (*QueryLocksByValidatorResponse, error)
+	// LockHistory queries the recorded lock changes of an address, oldest
+	// first.
+	LockHistory(context.Context, *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error)
+	// SpendableBondBalance queries the bond denom balance an address can send,
+	// given the part of its locks not covered by delegations.
+	SpendableBondBalance(context.Context, *QuerySpendableBondBalanceRequest) (*QuerySpendableBondBalanceResponse, error)
+	// MaxUndelegatable queries the amount an address can undelegate from a
+	// validator without its delegations dropping below its locks.
+	MaxUndelegatable(context.Context, *QueryMaxUndelegatableRequest) (*QueryMaxUndelegatableResponse, error)
+	// LockAllowance queries the allowance of spender to lock on behalf of
+	// owner.
+	LockAllowance(context.Context, *QueryLockAllowanceRequest) (*QueryLockAllowanceResponse, error)
+	// LockTransferAcceptance queries whether address accepts the locks
+	// from_address transfers to it.
+	LockTransferAcceptance(context.Context, *QueryLockTransferAcceptanceRequest) (*QueryLockTransferAcceptanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -102,18 +290,54 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) ActiveLocks(context.Context, *QueryActiveLocksRequest) (*QueryActiveLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActiveLocks not implemented")
 }
 func (UnimplementedQueryServer) TotalLockedAmount(context.Context, *QueryTotalLockedAmountRequest) (*QueryTotalLockedAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalLockedAmount not implemented")
 }
+func (UnimplementedQueryServer) UnlockSchedule(context.Context, *QueryUnlockScheduleRequest) (*QueryUnlockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSchedule not implemented")
+}
+func (UnimplementedQueryServer) UnlockScheduleByAddress(context.Context, *QueryUnlockScheduleByAddressRequest) (*QueryUnlockScheduleByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockScheduleByAddress not implemented")
+}
 func (UnimplementedQueryServer) AccountLocks(context.Context, *QueryAccountLocksRequest) (*QueryAccountLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLocks not implemented")
 }
+func (UnimplementedQueryServer) AccountLocksBatch(context.Context, *QueryAccountLocksBatchRequest) (*QueryAccountLocksBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLocksBatch not implemented")
+}
 func (UnimplementedQueryServer) Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
 }
+func (UnimplementedQueryServer) SlashAdjustments(context.Context, *QuerySlashAdjustmentsRequest) (*QuerySlashAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashAdjustments not implemented")
+}
+func (UnimplementedQueryServer) LockStatus(context.Context, *QueryLockStatusRequest) (*QueryLockStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockStatus not implemented")
+}
+func (UnimplementedQueryServer) LocksByValidator(context.Context, *QueryLocksByValidatorRequest) (*QueryLocksByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocksByValidator not implemented")
+}
+func (UnimplementedQueryServer) LockHistory(context.Context, *QueryLockHistoryRequest) (*QueryLockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockHistory not implemented")
+}
+func (UnimplementedQueryServer) SpendableBondBalance(context.Context, *QuerySpendableBondBalanceRequest) (*QuerySpendableBondBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendableBondBalance not implemented")
+}
+func (UnimplementedQueryServer) MaxUndelegatable(context.Context, *QueryMaxUndelegatableRequest) (*QueryMaxUndelegatableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxUndelegatable not implemented")
+}
+func (UnimplementedQueryServer) LockAllowance(context.Context, *QueryLockAllowanceRequest) (*QueryLockAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockAllowance not implemented")
+}
+func (UnimplementedQueryServer) LockTransferAcceptance(context.Context, *QueryLockTransferAcceptanceRequest) (*QueryLockTransferAcceptanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockTransferAcceptance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +351,24 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ActiveLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActiveLocksRequest)
 	if err := dec(in); err != nil {
@@ -163,6 +405,42 @@ func _Query_TotalLockedAmount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UnlockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockSchedule(ctx, req.(*QueryUnlockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnlockScheduleByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnlockScheduleByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnlockScheduleByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnlockScheduleByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnlockScheduleByAddress(ctx, req.(*QueryUnlockScheduleByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAccountLocksRequest)
 	if err := dec(in); err != nil {
@@ -181,6 +459,24 @@ func _Query_AccountLocks_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountLocksBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLocksBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLocksBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLocksBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLocksBatch(ctx, req.(*QueryAccountLocksBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLocksRequest)
 	if err := dec(in); err != nil {
@@ -199,6 +495,150 @@ func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashAdjustments(ctx, req.(*QuerySlashAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockStatus(ctx, req.(*QueryLockStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LocksByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LocksByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LocksByValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LocksByValidator(ctx, req.(*QueryLocksByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockHistory(ctx, req.(*QueryLockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendableBondBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendableBondBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendableBondBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendableBondBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendableBondBalance(ctx, req.(*QuerySpendableBondBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxUndelegatable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxUndelegatableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxUndelegatable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaxUndelegatable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxUndelegatable(ctx, req.(*QueryMaxUndelegatableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockAllowance(ctx, req.(*QueryLockAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockTransferAcceptance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockTransferAcceptanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockTransferAcceptance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockTransferAcceptance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockTransferAcceptance(ctx, req.(*QueryLockTransferAcceptanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,6 +646,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "lockup.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 		{
 			MethodName: "ActiveLocks",
 			Handler:    _Query_ActiveLocks_Handler,
@@ -214,14 +658,58 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TotalLockedAmount",
 			Handler:    _Query_TotalLockedAmount_Handler,
 		},
+		{
+			MethodName: "UnlockSchedule",
+			Handler:    _Query_UnlockSchedule_Handler,
+		},
+		{
+			MethodName: "UnlockScheduleByAddress",
+			Handler:    _Query_UnlockScheduleByAddress_Handler,
+		},
 		{
 			MethodName: "AccountLocks",
 			Handler:    _Query_AccountLocks_Handler,
 		},
+		{
+			MethodName: "AccountLocksBatch",
+			Handler:    _Query_AccountLocksBatch_Handler,
+		},
 		{
 			MethodName: "Locks",
 			Handler:    _Query_Locks_Handler,
 		},
+		{
+			MethodName: "SlashAdjustments",
+			Handler:    _Query_SlashAdjustments_Handler,
+		},
+		{
+			MethodName: "LockStatus",
+			Handler:    _Query_LockStatus_Handler,
+		},
+		{
+			MethodName: "LocksByValidator",
+			Handler:    _Query_LocksByValidator_Handler,
+		},
+		{
+			MethodName: "LockHistory",
+			Handler:    _Query_LockHistory_Handler,
+		},
+		{
+			MethodName: "SpendableBondBalance",
+			Handler:    _Query_SpendableBondBalance_Handler,
+		},
+		{
+			MethodName: "MaxUndelegatable",
+			Handler:    _Query_MaxUndelegatable_Handler,
+		},
+		{
+			MethodName: "LockAllowance",
+			Handler:    _Query_LockAllowance_Handler,
+		},
+		{
+			MethodName: "LockTransferAcceptance",
+			Handler:    _Query_LockTransferAcceptance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lockup/v1/query.proto",
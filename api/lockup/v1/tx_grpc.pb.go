@@ -23,6 +23,13 @@ const (
 	Msg_Extend_FullMethodName                   = "/lockup.v1.Msg/Extend"
 	Msg_SendDelegateAndLock_FullMethodName      = "/lockup.v1.Msg/SendDelegateAndLock"
 	Msg_MultiSendDelegateAndLock_FullMethodName = "/lockup.v1.Msg/MultiSendDelegateAndLock"
+	Msg_UnlockEarly_FullMethodName              = "/lockup.v1.Msg/UnlockEarly"
+	Msg_TransferLock_FullMethodName             = "/lockup.v1.Msg/TransferLock"
+	Msg_AcceptLockTransfers_FullMethodName      = "/lockup.v1.Msg/AcceptLockTransfers"
+	Msg_LockWithSchedule_FullMethodName         = "/lockup.v1.Msg/LockWithSchedule"
+	Msg_ForceUnlock_FullMethodName              = "/lockup.v1.Msg/ForceUnlock"
+	Msg_AdjustLock_FullMethodName               = "/lockup.v1.Msg/AdjustLock"
+	Msg_UpdateParams_FullMethodName             = "/lockup.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -37,6 +44,20 @@ type MsgClient interface {
 	SendDelegateAndLock(ctx context.Context, in *MsgSendDelegateAndLock, opts ...grpc.CallOption) (*MsgSendDelegateAndLockResponse, error)
 	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
 	MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSendDelegateAndLock, opts ...grpc.CallOption) (*MsgMultiSendDelegateAndLockResponse, error)
+	// UnlockEarly releases some or all of a lock before its unlock date against a penalty.
+	UnlockEarly(ctx context.Context, in *MsgUnlockEarly, opts ...grpc.CallOption) (*MsgUnlockEarlyResponse, error)
+	// TransferLock moves a lock and the delegation backing it to another address.
+	TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error)
+	// AcceptLockTransfers accepts or refuses the locks another address transfers to the signer.
+	AcceptLockTransfers(ctx context.Context, in *MsgAcceptLockTransfers, opts ...grpc.CallOption) (*MsgAcceptLockTransfersResponse, error)
+	// LockWithSchedule sends tokens to an address, delegates them to a validator, and locks them under a graduated release schedule.
+	LockWithSchedule(ctx context.Context, in *MsgLockWithSchedule, opts ...grpc.CallOption) (*MsgLockWithScheduleResponse, error)
+	// ForceUnlock defines a governance operation for removing the locks of an address.
+	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
+	// AdjustLock defines a governance operation for setting the amount of a lock.
+	AdjustLock(ctx context.Context, in *MsgAdjustLock, opts ...grpc.CallOption) (*MsgAdjustLockResponse, error)
+	// UpdateParams defines a governance operation for updating the parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -83,6 +104,69 @@ func (c *msgClient) MultiSendDelegateAndLock(ctx context.Context, in *MsgMultiSe
 	return out, nil
 }
 
+func (c *msgClient) UnlockEarly(ctx context.Context, in *MsgUnlockEarly, opts ...grpc.CallOption) (*MsgUnlockEarlyResponse, error) {
+	out := new(MsgUnlockEarlyResponse)
+	err := c.cc.Invoke(ctx, Msg_UnlockEarly_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLock(ctx context.Context, in *MsgTransferLock, opts ...grpc.CallOption) (*MsgTransferLockResponse, error) {
+	out := new(MsgTransferLockResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptLockTransfers(ctx context.Context, in *MsgAcceptLockTransfers, opts ...grpc.CallOption) (*MsgAcceptLockTransfersResponse, error) {
+	out := new(MsgAcceptLockTransfersResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptLockTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockWithSchedule(ctx context.Context, in *MsgLockWithSchedule, opts ...grpc.CallOption) (*MsgLockWithScheduleResponse, error) {
+	out := new(MsgLockWithScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_LockWithSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error) {
+	out := new(MsgForceUnlockResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceUnlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AdjustLock(ctx context.Context, in *MsgAdjustLock, opts ...grpc.CallOption) (*MsgAdjustLockResponse, error) {
+	out := new(MsgAdjustLockResponse)
+	err := c.cc.Invoke(ctx, Msg_AdjustLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +179,20 @@ type MsgServer interface {
 	SendDelegateAndLock(context.Context, *MsgSendDelegateAndLock) (*MsgSendDelegateAndLockResponse, error)
 	// MultiSendDelegateAndLock sends tokens to multiple addresses, delegates them to a validator, and locks them until specified unlock dates.
 	MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error)
+	// UnlockEarly releases some or all of a lock before its unlock date against a penalty.
+	UnlockEarly(context.Context, *MsgUnlockEarly) (*MsgUnlockEarlyResponse, error)
+	// TransferLock moves a lock and the delegation backing it to another address.
+	TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error)
+	// AcceptLockTransfers accepts or refuses the locks another address transfers to the signer.
+	AcceptLockTransfers(context.Context, *MsgAcceptLockTransfers) (*MsgAcceptLockTransfersResponse, error)
+	// LockWithSchedule sends tokens to an address, delegates them to a validator, and locks them under a graduated release schedule.
+	LockWithSchedule(context.Context, *MsgLockWithSchedule) (*MsgLockWithScheduleResponse, error)
+	// ForceUnlock defines a governance operation for removing the locks of an address.
+	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
+	// AdjustLock defines a governance operation for setting the amount of a lock.
+	AdjustLock(context.Context, *MsgAdjustLock) (*MsgAdjustLockResponse, error)
+	// UpdateParams defines a governance operation for updating the parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -114,6 +212,27 @@ func (UnimplementedMsgServer) SendDelegateAndLock(context.Context, *MsgSendDeleg
 func (UnimplementedMsgServer) MultiSendDelegateAndLock(context.Context, *MsgMultiSendDelegateAndLock) (*MsgMultiSendDelegateAndLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiSendDelegateAndLock not implemented")
 }
+func (UnimplementedMsgServer) UnlockEarly(context.Context, *MsgUnlockEarly) (*MsgUnlockEarlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockEarly not implemented")
+}
+func (UnimplementedMsgServer) TransferLock(context.Context, *MsgTransferLock) (*MsgTransferLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLock not implemented")
+}
+func (UnimplementedMsgServer) AcceptLockTransfers(context.Context, *MsgAcceptLockTransfers) (*MsgAcceptLockTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptLockTransfers not implemented")
+}
+func (UnimplementedMsgServer) LockWithSchedule(context.Context, *MsgLockWithSchedule) (*MsgLockWithScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockWithSchedule not implemented")
+}
+func (UnimplementedMsgServer) ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUnlock not implemented")
+}
+func (UnimplementedMsgServer) AdjustLock(context.Context, *MsgAdjustLock) (*MsgAdjustLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustLock not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +318,132 @@ func _Msg_MultiSendDelegateAndLock_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnlockEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockEarly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockEarly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnlockEarly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockEarly(ctx, req.(*MsgUnlockEarly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLock(ctx, req.(*MsgTransferLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptLockTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptLockTransfers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptLockTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptLockTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptLockTransfers(ctx, req.(*MsgAcceptLockTransfers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockWithSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockWithSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockWithSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockWithSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockWithSchedule(ctx, req.(*MsgLockWithSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceUnlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceUnlock(ctx, req.(*MsgForceUnlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AdjustLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAdjustLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AdjustLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AdjustLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AdjustLock(ctx, req.(*MsgAdjustLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -222,6 +467,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiSendDelegateAndLock",
 			Handler:    _Msg_MultiSendDelegateAndLock_Handler,
 		},
+		{
+			MethodName: "UnlockEarly",
+			Handler:    _Msg_UnlockEarly_Handler,
+		},
+		{
+			MethodName: "TransferLock",
+			Handler:    _Msg_TransferLock_Handler,
+		},
+		{
+			MethodName: "AcceptLockTransfers",
+			Handler:    _Msg_AcceptLockTransfers_Handler,
+		},
+		{
+			MethodName: "LockWithSchedule",
+			Handler:    _Msg_LockWithSchedule_Handler,
+		},
+		{
+			MethodName: "ForceUnlock",
+			Handler:    _Msg_ForceUnlock_Handler,
+		},
+		{
+			MethodName: "AdjustLock",
+			Handler:    _Msg_AdjustLock_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lockup/v1/tx.proto",
@@ -31,7 +31,11 @@ func (d RedelegationMarkerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		if err != nil {
 			return ctx, err
 		}
-		ctx = ctx.WithContext(lockupkeeper.WithRedelegating(ctx, delAddr, valSrcAddr))
+		valDstAddr, err := sdk.ValAddressFromBech32(redelegate.ValidatorDstAddress)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = ctx.WithContext(lockupkeeper.WithRedelegating(ctx, delAddr, valSrcAddr, valDstAddr))
 	}
 	return next(ctx, tx, simulate)
 }
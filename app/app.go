@@ -29,7 +29,9 @@ import (
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	chainante "github.com/TrustedSmartChain/tsc/v2/app/ante"
+	distroprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/distro"
 	lockupprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/lockup"
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding"
 	distro "github.com/TrustedSmartChain/tsc/v2/x/distro"
 	distrokeeper "github.com/TrustedSmartChain/tsc/v2/x/distro/keeper"
 	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
@@ -208,6 +210,7 @@ var maccPerms = map[string][]string{
 	distrotypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
 	precisebanktypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	wasmtypes.ModuleName:           {authtypes.Burner},
+	lockuptypes.ModuleName:         {authtypes.Burner},
 }
 
 var (
@@ -450,7 +453,8 @@ func NewChainApp(
 		appCodec,
 		legacyAmino,
 		runtime.NewKVStoreService(keys[slashingtypes.StoreKey]),
-		app.StakingKeeper,
+		// marks the slashes so the lockup hooks reduce the locks they uncover
+		lockupkeeper.NewSlashingStakingKeeper(app.StakingKeeper),
 		authAddr,
 	)
 
@@ -533,7 +537,7 @@ func NewChainApp(
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// Create the lockup Keeper
-	app.LockupKeeper = lockupkeeper.NewKeeper(
+	lockupKeeper := lockupkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[lockuptypes.StoreKey]),
 		logger,
@@ -541,8 +545,14 @@ func NewChainApp(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.DistrKeeper,
 	)
 
+	// No module subscribes to the lockup hooks yet. Modules that do register
+	// with lockupKeeper.SetHooks here, before the keeper is copied into the
+	// send restriction, the staking hooks and the precompile below.
+	app.LockupKeeper = lockupKeeper
+
 	// Register the lockup send restriction on the bank keeper so that locked
 	// tokens cannot be transferred via *any* path (Cosmos SDK msgs, EVM
 	// native transfers, IBC, etc.).
@@ -626,6 +636,14 @@ func NewChainApp(
 	)
 	app.EVMKeeper.RegisterStaticPrecompile(lockupPrecompile.Address(), lockupPrecompile)
 
+	// Register the distro precompile
+	distroPrecompile := distroprecompile.NewPrecompile(
+		app.DistroKeeper,
+		distrokeeper.NewMsgServerImpl(app.DistroKeeper),
+		app.BankKeeper,
+	)
+	app.EVMKeeper.RegisterStaticPrecompile(distroPrecompile.Address(), distroPrecompile)
+
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
 		appCodec,
@@ -724,7 +742,14 @@ func NewChainApp(
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
-	availableCapabilities := wasmkeeper.BuiltInCapabilities()
+	availableCapabilities := append(wasmkeeper.BuiltInCapabilities(), wasmbinding.CustomCapability)
+	wasmOpts := wasmbinding.RegisterCustomPlugins(
+		appCodec,
+		app.GRPCQueryRouter(),
+		app.LockupKeeper,
+		app.StakingKeeper,
+		app.DistroKeeper,
+	)
 	app.WasmKeeper = wasmkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[wasmtypes.StoreKey]),
@@ -742,6 +767,7 @@ func NewChainApp(
 		wasmtypes.VMConfig{},
 		availableCapabilities,
 		authAddr,
+		wasmOpts...,
 	)
 
 	// Create wasm IBC stack
@@ -1261,7 +1287,7 @@ func BlockedAddresses() map[string]bool {
 	// allow the following addresses to receive funds
 	delete(blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	blockedPrecompilesHex := append(evmtypes.AvailableStaticPrecompiles, lockupprecompile.LockupPrecompileAddress) //nolint:gocritic
+	blockedPrecompilesHex := append(evmtypes.AvailableStaticPrecompiles, lockupprecompile.LockupPrecompileAddress, distroprecompile.DistroPrecompileAddress) //nolint:gocritic
 	for _, precompile := range blockedPrecompilesHex {
 		blockedAddrs[utils.EthHexToCosmosAddr(precompile).String()] = true
 	}
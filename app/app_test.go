@@ -13,6 +13,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding"
 )
 
 func TestAppExport(t *testing.T) {
@@ -60,6 +62,15 @@ func TestBlockedAddrs(t *testing.T) {
 	}
 }
 
+// ensure that every query contracts may send is routed
+func TestWasmAcceptedQueries(t *testing.T) {
+	gapp, _ := setup(false, 5, "chain-test", 9001)
+
+	for path := range wasmbinding.AcceptedQueries() {
+		require.NotNil(t, gapp.GRPCQueryRouter().Route(path), path)
+	}
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
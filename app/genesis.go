@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"sort"
 
+	distroprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/distro"
 	lockupprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/lockup"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -35,8 +36,8 @@ func NewEVMGenesisState() *evmtypes.GenesisState {
 	evmGenState.Params.EvmDenom = BaseDenom
 	evmGenState.Params.ExtendedDenomOptions = &evmtypes.ExtendedDenomOptions{ExtendedDenom: BaseDenom}
 
-	// Include the default precompiles plus the custom lockup precompile
-	activePrecompiles := append(evmtypes.AvailableStaticPrecompiles, lockupprecompile.LockupPrecompileAddress) //nolint:gocritic // append to new slice
+	// Include the default precompiles plus the custom lockup and distro precompiles
+	activePrecompiles := append(evmtypes.AvailableStaticPrecompiles, lockupprecompile.LockupPrecompileAddress, distroprecompile.DistroPrecompileAddress) //nolint:gocritic // append to new slice
 	sort.Strings(activePrecompiles)
 	evmGenState.Params.ActiveStaticPrecompiles = activePrecompiles
 	evmGenState.Preinstalls = evmtypes.DefaultPreinstalls
@@ -99,7 +100,7 @@ func NewBankGenesisState() *banktypes.GenesisState {
 // ---------------------------------------------------------------------------
 // These embed the upstream AppModuleBasic and override only DefaultGenesis
 // so that `tscd init` produces a genesis with the chain's custom defaults
-// (e.g. the lockup precompile in ActiveStaticPrecompiles, correct bond denom,
+// (e.g. the lockup and distro precompiles in ActiveStaticPrecompiles, correct bond denom,
 // denom metadata, etc.) without relying on shell-script jq overrides.
 
 // evmAppModuleBasic wraps the EVM module's AppModuleBasic.
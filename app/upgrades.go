@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	distroprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/distro"
 	lockupprecompile "github.com/TrustedSmartChain/tsc/v2/precompiles/lockup"
 	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -20,7 +21,21 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 )
 
-const UpgradeName = "v2-lockup"
+const (
+	UpgradeName = "v2-lockup"
+
+	// LockupStoreUpgradeName runs the x/lockup v1 -> v2 store migration on
+	// chains that already went through UpgradeName.
+	LockupStoreUpgradeName = "v2-lockup-store"
+
+	// DistroPrecompileUpgradeName enables the distro precompile on chains
+	// that already went through UpgradeName.
+	DistroPrecompileUpgradeName = "v2-distro-precompile"
+
+	// LockScheduleIndexUpgradeName runs the x/lockup v2 -> v3 store
+	// migration, which indexes the lock schedules by their next release.
+	LockScheduleIndexUpgradeName = "v2-lockup-schedules"
+)
 
 func (app *ChainApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -81,6 +96,42 @@ func (app *ChainApp) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		LockupStoreUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			sdkCtx.Logger().Info("Migrating lockup store", "from_version", fromVM[lockuptypes.ModuleName])
+
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		DistroPrecompileUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+			// Enable the distro precompile for existing chains
+			sdkCtx.Logger().Info("Enabling distro precompile", "address", distroprecompile.DistroPrecompileAddress)
+			if err := app.EVMKeeper.EnableStaticPrecompiles(sdkCtx, common.HexToAddress(distroprecompile.DistroPrecompileAddress)); err != nil {
+				return nil, err
+			}
+			sdkCtx.Logger().Info("Distro precompile enabled successfully")
+
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
+	app.UpgradeKeeper.SetUpgradeHandler(
+		LockScheduleIndexUpgradeName,
+		func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			sdkCtx.Logger().Info("Migrating lockup store", "from_version", fromVM[lockuptypes.ModuleName])
+
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
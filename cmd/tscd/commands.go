@@ -18,7 +18,6 @@ import (
 
 	cmtcli "github.com/cometbft/cometbft/libs/cli"
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/client/debug"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/pruning"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -111,7 +110,7 @@ func initRootCmd(
 		genutilcli.InitCmd(chainApp.BasicModuleManager, app.DefaultNodeHome),
 		genutilcli.Commands(chainApp.TxConfig(), chainApp.BasicModuleManager, app.DefaultNodeHome),
 		cmtcli.NewCompletionCmd(rootCmd, true),
-		debug.Cmd(),
+		debugCommand(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(sdkAppCreator, app.DefaultNodeHome),
 		snapshot.Cmd(sdkAppCreator),
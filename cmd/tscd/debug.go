@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/pruning"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/TrustedSmartChain/tsc/v2/app"
+)
+
+const flagBlockTime = "block-time"
+
+// debugCommand returns the SDK debug command extended with the chain's own
+// offline tools
+func debugCommand() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(lockupInvariantsCmd())
+	return cmd
+}
+
+// lockupInvariantsCmd runs the lockup invariants against the latest state of
+// the local application database. The invariants walk the whole lockup state,
+// so they are not exposed by the query services; the node should be stopped
+// while the command runs.
+func lockupInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lockup-invariants",
+		Short: "Run the lockup invariants against the local node state and list the offending addresses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			blockTime := time.Now().UTC()
+			if s := vp.GetString(flagBlockTime); s != "" {
+				t, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", flagBlockTime, err)
+				}
+				blockTime = t
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			chainApp := app.NewChainApp(log.NewNopLogger(), db, nil, true, vp)
+			height := chainApp.LastBlockHeight()
+			if height <= 0 {
+				return fmt.Errorf("the database has no committed state")
+			}
+
+			ctx := chainApp.NewUncachedContext(false, cmtproto.Header{Height: height, Time: blockTime})
+
+			broken := 0
+			for _, res := range chainApp.LockupKeeper.CheckInvariants(ctx) {
+				status := "ok"
+				if res.Broken {
+					status = "BROKEN"
+					broken++
+				}
+				cmd.Printf("%s: %s\n%s", res.Name, status, res.Message)
+			}
+
+			if broken > 0 {
+				return fmt.Errorf("%d lockup invariants broken at height %d", broken, height)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(pruning.FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+	cmd.Flags().String(flagBlockTime, "", "Block time (RFC3339) the locks are evaluated at, defaults to now")
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/app"
+)
+
+// envTscdArgs makes the test binary run tscd with the given arguments. The
+// EVM chain config is a process global that the node app can only set once,
+// so each command runs in its own process.
+const envTscdArgs = "TSCD_TEST_ARGS"
+
+func TestMain(m *testing.M) {
+	if args := os.Getenv(envTscdArgs); args != "" {
+		os.Args = append([]string{"tscd"}, strings.Split(args, "\n")...)
+		main()
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// runTscd runs tscd with args and returns its combined output and exit code
+func runTscd(t *testing.T, args ...string) (string, int) {
+	t.Helper()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(), envTscdArgs+"="+strings.Join(args, "\n"))
+
+	out, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return string(out), exitErr.ExitCode()
+	}
+	require.NoError(t, err)
+
+	return string(out), 0
+}
+
+// commitLockupState opens the application database of home, applies write to
+// the lockup state and commits it as the next height
+func commitLockupState(t *testing.T, home string, write func(ctx sdk.Context, chainApp *app.ChainApp)) {
+	t.Helper()
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// The app is not loaded on creation, so it leaves the EVM chain config
+	// unset, and its wasm VM, which keeps its directory locked, lives apart.
+	chainApp := app.NewChainApp(log.NewNopLogger(), db, nil, false, simtestutil.NewAppOptionsWithFlagHome(t.TempDir()))
+	require.NoError(t, chainApp.LoadLatestVersion())
+
+	write(chainApp.NewUncachedContext(false, cmtproto.Header{}), chainApp)
+	chainApp.CommitMultiStore().Commit()
+}
+
+func TestLockupInvariantsCmd(t *testing.T) {
+	home := t.TempDir()
+	args := []string{
+		"debug", "lockup-invariants",
+		"--" + flags.FlagHome, home,
+		"--" + flagBlockTime, "2026-01-01T00:00:00Z",
+	}
+
+	// a database without committed state is refused
+	out, code := runTscd(t, args...)
+	require.Equal(t, 1, code)
+	require.Contains(t, out, "the database has no committed state")
+
+	commitLockupState(t, home, func(sdk.Context, *app.ChainApp) {})
+
+	out, code = runTscd(t, args...)
+	require.Equal(t, 0, code, out)
+	require.Contains(t, out, "total-locked: ok\n")
+	require.Contains(t, out, "locks-index: ok\n")
+	require.Contains(t, out, "delegation-coverage: ok\n")
+
+	// a queue entry without the lock of its address breaks the locks index
+	addr := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	commitLockupState(t, home, func(ctx sdk.Context, chainApp *app.ChainApp) {
+		unlockTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, chainApp.LockupKeeper.AddToExpirationQueue(ctx, unlockTime, addr, math.NewInt(100)))
+	})
+
+	// tscd prints the address with the chain prefix
+	offender := sdk.MustBech32ifyAddressBytes(app.Bech32PrefixAccAddr, addr)
+
+	out, code = runTscd(t, args...)
+	require.Equal(t, 1, code, out)
+	require.Contains(t, out, "total-locked: ok\n")
+	require.Contains(t, out, fmt.Sprintf("locks-index: BROKEN\n\t1 addresses with locks not matching the expiration queue\n\t\t%s\n", offender))
+	require.Contains(t, out, "delegation-coverage: ok\n")
+	require.Contains(t, out, "1 lockup invariants broken at height 2")
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The DistroI contract's address.
+address constant DISTRO_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000901;
+
+/// @dev The DistroI contract's instance.
+DistroI constant DISTRO_CONTRACT = DistroI(DISTRO_PRECOMPILE_ADDRESS);
+
+/// @dev Define all the available distro methods.
+string constant MSG_MINT = "/distro.v1.MsgMint";
+
+/// @dev Represents the params of the distro module.
+struct Params {
+    address mintingAddress;
+    address receivingAddress;
+    string denom;
+    uint256 maxSupply;
+    string distributionStartDate;
+    uint64 monthsInHalvingPeriod;
+}
+
+/// @author TrustedSmartChain Team
+/// @title Distro Precompiled Contract
+/// @dev The interface through which solidity contracts will interact with the distro module.
+/// @custom:address 0x0000000000000000000000000000000000000901
+interface DistroI {
+    /// @dev Mint tokens to the receiving address of the params. Only the
+    /// minting address of the params can mint, up to the amount of mintableNow.
+    /// @param amount The amount of tokens to mint
+    /// @return success Whether or not the mint was successful
+    function mint(uint256 amount) external returns (bool success);
+
+    /// @dev Get the params of the distro module.
+    /// @return params The distro params
+    function params() external view returns (Params memory params);
+
+    /// @dev Get the supply the halving schedule allows by the day of a timestamp.
+    /// @param timestamp The date to get the limit at, in Unix seconds
+    /// @return amount The distributable limit, zero before the distribution start date
+    function totalDistributableAt(
+        uint64 timestamp
+    ) external view returns (uint256 amount);
+
+    /// @dev Get the amount the minting address can mint at the current block time.
+    /// @return amount The amount that can be minted now
+    function mintableNow() external view returns (uint256 amount);
+
+    /// @dev Mint defines an Event emitted when tokens are minted.
+    /// @param minter The minting address
+    /// @param receiver The address receiving the minted tokens
+    /// @param amount The amount of tokens minted
+    event Mint(
+        address indexed minter,
+        address indexed receiver,
+        uint256 amount
+    );
+}
@@ -0,0 +1,148 @@
+package distro
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	_ "embed"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distrokeeper "github.com/TrustedSmartChain/tsc/v2/x/distro/keeper"
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+const (
+	// DistroPrecompileAddress defines the precompile address for the distro module.
+	DistroPrecompileAddress = "0x0000000000000000000000000000000000000901"
+)
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   []byte
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(f))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile defines the precompiled contract for distro.
+type Precompile struct {
+	cmn.Precompile
+	abi.ABI
+	distroKeeper    distrokeeper.Keeper
+	distroMsgServer distrotypes.MsgServer
+}
+
+// NewPrecompile creates a new distro Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	distroKeeper distrokeeper.Keeper,
+	distroMsgServer distrotypes.MsgServer,
+	bankKeeper cmn.BankKeeper,
+) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(DistroPrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:             ABI,
+		distroKeeper:    distroKeeper,
+		distroMsgServer: distroMsgServer,
+	}
+}
+
+// Address defines the address of the distro precompile contract.
+func (p Precompile) Address() common.Address {
+	return p.ContractAddress
+}
+
+// Logger returns a precompile-specific logger.
+func (p Precompile) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("precompile", "distro")
+}
+
+// RequiredGas returns the required bare minimum gas to execute the precompile.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	methodID := input[:4]
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+// Run executes the precompile contract distro methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+// Execute runs the precompile contract distro methods.
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	var bz []byte
+
+	switch method.Name {
+	// Distro transactions
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	// Distro queries
+	case ParamsMethod:
+		bz, err = p.Params(ctx, method, contract, args)
+	case TotalDistributableAtMethod:
+		bz, err = p.TotalDistributableAt(ctx, method, contract, args)
+	case MintableNowMethod:
+		bz, err = p.MintableNow(ctx, method, contract, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a write operation.
+func (p Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case MintMethod:
+		return true
+	default:
+		return false
+	}
+}
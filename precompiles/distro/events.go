@@ -0,0 +1,73 @@
+package distro
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+)
+
+const (
+	// EventTypeMint defines the event type for the distro Mint transaction.
+	EventTypeMint = "Mint"
+)
+
+// EventMint defines the event data for the distro Mint transaction.
+type EventMint struct {
+	Minter   common.Address
+	Receiver common.Address
+	Amount   *big.Int
+}
+
+// EmitMintEvent creates a new event emitted on a Mint transaction.
+func (p Precompile) EmitMintEvent(ctx sdk.Context, stateDB vm.StateDB, msg *distrotypes.MsgMint, minter, receiver common.Address) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeMint]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(minter)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(receiver)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	amount, ok := sdkmath.NewIntFromString(msg.Amount)
+	if !ok {
+		return fmt.Errorf(cmn.ErrInvalidAmount, msg.Amount)
+	}
+
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
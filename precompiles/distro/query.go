@@ -0,0 +1,123 @@
+package distro
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ParamsMethod defines the ABI method name for the distro Params query.
+	ParamsMethod = "params"
+	// TotalDistributableAtMethod defines the ABI method name for the distro distributable limit query.
+	TotalDistributableAtMethod = "totalDistributableAt"
+	// MintableNowMethod defines the ABI method name for the distro mintable amount query.
+	MintableNowMethod = "mintableNow"
+)
+
+// ParamsOutput represents the distro params returned to the EVM caller.
+type ParamsOutput struct {
+	MintingAddress        common.Address `abi:"mintingAddress"`
+	ReceivingAddress      common.Address `abi:"receivingAddress"`
+	Denom                 string         `abi:"denom"`
+	MaxSupply             *big.Int       `abi:"maxSupply"`
+	DistributionStartDate string         `abi:"distributionStartDate"`
+	MonthsInHalvingPeriod uint64         `abi:"monthsInHalvingPeriod"`
+}
+
+// Params returns the distro params.
+func (p Precompile) Params(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	params, err := p.distroKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mintingAddr, err := sdk.AccAddressFromBech32(params.MintingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	receivingAddr, err := sdk.AccAddressFromBech32(params.ReceivingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSupply, ok := sdkmath.NewIntFromString(params.MaxSupply)
+	if !ok {
+		return nil, fmt.Errorf("invalid max supply: %s", params.MaxSupply)
+	}
+
+	return method.Outputs.Pack(ParamsOutput{
+		MintingAddress:        common.BytesToAddress(mintingAddr),
+		ReceivingAddress:      common.BytesToAddress(receivingAddr),
+		Denom:                 params.Denom,
+		MaxSupply:             maxSupply.BigInt(),
+		DistributionStartDate: params.DistributionStartDate,
+		MonthsInHalvingPeriod: params.MonthsInHalvingPeriod,
+	})
+}
+
+// TotalDistributableAt returns the supply the halving schedule allows by the
+// day of a Unix timestamp.
+func (p Precompile) TotalDistributableAt(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	timestamp, ok := args[0].(uint64)
+	if !ok || timestamp > math.MaxInt64 {
+		return nil, fmt.Errorf("invalid timestamp: %v", args[0])
+	}
+
+	total, err := p.distroKeeper.TotalDistributableAt(ctx, time.Unix(int64(timestamp), 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(total.BigInt())
+}
+
+// MintableNow returns the amount the minting address can mint at the current
+// block time.
+func (p Precompile) MintableNow(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	mintable, err := p.distroKeeper.MintableNow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(mintable.BigInt())
+}
@@ -0,0 +1,82 @@
+package distro
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+)
+
+const (
+	// MintMethod defines the ABI method name for the distro Mint transaction.
+	MintMethod = "mint"
+)
+
+// Mint mints tokens to the receiving address. The message server only
+// accepts it when the caller is the minting address of the params.
+func (p *Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, err := NewMsgMint(args, contract.Caller())
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ minter: %s, amount: %s }", msg.Minter, msg.Amount),
+	)
+
+	params, err := p.distroKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute the transaction using the message server
+	if _, err = p.distroMsgServer.Mint(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	receivingAddr, err := sdk.AccAddressFromBech32(params.ReceivingAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the mint transaction
+	if err = p.EmitMintEvent(ctx, stateDB, msg, contract.Caller(), common.BytesToAddress(receivingAddr)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// NewMsgMint creates a new MsgMint from the provided arguments.
+// args: [amount (uint256)]
+func NewMsgMint(args []interface{}, minter common.Address) (*distrotypes.MsgMint, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount.Sign() <= 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[0])
+	}
+
+	return &distrotypes.MsgMint{
+		Minter: sdk.AccAddress(minter.Bytes()).String(),
+		Amount: amount.String(),
+	}, nil
+}
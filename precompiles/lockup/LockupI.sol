@@ -17,6 +17,8 @@ LockupI constant LOCKUP_CONTRACT = LockupI(LOCKUP_PRECOMPILE_ADDRESS);
 string constant MSG_LOCK = "/lockup.v1.MsgLock";
 string constant MSG_EXTEND = "/lockup.v1.MsgExtend";
 string constant MSG_SEND_DELEGATE_AND_LOCK = "/lockup.v1.MsgSendDelegateAndLock";
+string constant MSG_MULTI_SEND_DELEGATE_AND_LOCK = "/lockup.v1.MsgMultiSendDelegateAndLock";
+string constant MSG_UNLOCK_EARLY = "/lockup.v1.MsgUnlockEarly";
 
 /// @dev Represents a lock extension request.
 struct LockExtension {
@@ -25,11 +27,42 @@ struct LockExtension {
     Coin amount;
 }
 
-/// @dev Represents a single lock entry for a user.
+/// @dev Represents one recipient of a multiSendDelegateAndLock call.
+struct Output {
+    address toAddress;
+    string validatorAddress;
+    string unlockDate;
+    uint256 amount;
+}
+
+/// @dev Represents a lock extension request with Unix unlock times in seconds.
+struct LockExtensionTime {
+    uint64 fromTime;
+    uint64 toTime;
+    uint256 amount;
+}
+
+/// @dev Represents a single lock entry for a user. unlockTime is unlockDate in Unix seconds.
 struct LockInfo {
     string unlockDate;
     string denom;
     uint256 amount;
+    uint64 unlockTime;
+}
+
+/// @dev Represents the active locks of an account.
+struct AccountLockInfo {
+    address account;
+    LockInfo[] locks;
+}
+
+/// @dev Represents an active lock of any account. unlockTime is unlockDate in Unix seconds.
+struct ActiveLockInfo {
+    address account;
+    string unlockDate;
+    string denom;
+    uint256 amount;
+    uint64 unlockTime;
 }
 
 /// @author TrustedSmartChain Team
@@ -37,9 +70,10 @@ struct LockInfo {
 /// @dev The interface through which solidity contracts will interact with the lockup module.
 /// @custom:address 0x0000000000000000000000000000000000000900
 interface LockupI {
-    /// @dev Lock tokens for a specific address until an unlock date.
+    /// @dev Lock tokens for a specific address until an unlock date. The caller
+    /// must be lockAddress or have an allowance of lockAddress covering the lock.
     /// @param lockAddress The address whose tokens will be locked
-    /// @param unlockDate The date when the tokens will be unlocked (YYYY-MM-DD format)
+    /// @param unlockDate The date when the tokens will be unlocked (YYYY-MM-DD, RFC3339 timestamp or Unix seconds)
     /// @param amount The amount of tokens to lock (in bond denomination)
     /// @return success Whether or not the lock was successful
     function lock(
@@ -48,7 +82,8 @@ interface LockupI {
         uint256 amount
     ) external returns (bool success);
 
-    /// @dev Extend existing lock durations.
+    /// @dev Extend existing lock durations. The caller must be lockAddress or have
+    /// an allowance of lockAddress covering the total amount and the latest toDate.
     /// @param lockAddress The address whose lock will be extended
     /// @param extensions An array of LockExtension structs defining the extensions
     /// @return success Whether or not the extension was successful
@@ -60,7 +95,7 @@ interface LockupI {
     /// @dev Send tokens to an address, delegate them to a validator, and lock them.
     /// @param toAddress The recipient address
     /// @param validatorAddress The validator to delegate tokens to (bech32 format)
-    /// @param unlockDate The date when the tokens will be unlocked (YYYY-MM-DD format)
+    /// @param unlockDate The date when the tokens will be unlocked (YYYY-MM-DD, RFC3339 timestamp or Unix seconds)
     /// @param amount The amount of tokens to send, delegate, and lock
     /// @return success Whether or not the operation was successful
     function sendDelegateAndLock(
@@ -70,6 +105,89 @@ interface LockupI {
         uint256 amount
     ) external returns (bool success);
 
+    /// @dev Same as lock, with the unlock time in Unix seconds.
+    /// @param lockAddress The address whose tokens will be locked
+    /// @param unlockTime The time when the tokens will be unlocked, in Unix seconds
+    /// @param amount The amount of tokens to lock (in bond denomination)
+    /// @return success Whether or not the lock was successful
+    function lockUntil(
+        address lockAddress,
+        uint64 unlockTime,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Same as extend, with the unlock times in Unix seconds.
+    /// @param lockAddress The address whose lock will be extended
+    /// @param extensions An array of LockExtensionTime structs defining the extensions
+    /// @return success Whether or not the extension was successful
+    function extendTo(
+        address lockAddress,
+        LockExtensionTime[] calldata extensions
+    ) external returns (bool success);
+
+    /// @dev Same as sendDelegateAndLock, with the unlock time in Unix seconds.
+    /// @param toAddress The recipient address
+    /// @param validatorAddress The validator to delegate tokens to (bech32 format)
+    /// @param unlockTime The time when the tokens will be unlocked, in Unix seconds
+    /// @param amount The amount of tokens to send, delegate, and lock
+    /// @return success Whether or not the operation was successful
+    function sendDelegateAndLockUntil(
+        address toAddress,
+        string memory validatorAddress,
+        uint64 unlockTime,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Send tokens to several addresses, delegate them to validators, and lock
+    /// them, all or nothing. The outputs must add up to totalAmount, which must be
+    /// spendable by the caller. A SendDelegateAndLock event is emitted for each output.
+    /// @param totalAmount The total amount of tokens sent, the sum of the output amounts
+    /// @param outputs An array of Output structs, one per recipient
+    /// @return success Whether or not the operation was successful
+    function multiSendDelegateAndLock(
+        uint256 totalAmount,
+        Output[] calldata outputs
+    ) external returns (bool success);
+
+    /// @dev Release some or all of a lock before its unlock date. The penalty
+    /// set by governance is paid from the spendable balance of lockAddress.
+    /// @param lockAddress The address whose lock will be released, must be the caller
+    /// @param unlockDate The unlock date of the lock (YYYY-MM-DD, RFC3339 timestamp or Unix seconds)
+    /// @param amount The amount of tokens to release
+    /// @return penalty The amount charged as early unlock penalty
+    function unlockEarly(
+        address lockAddress,
+        string memory unlockDate,
+        uint256 amount
+    ) external returns (uint256 penalty);
+
+    /// @dev Allow spender to lock and extend the tokens of the caller, replacing
+    /// any previous allowance. A zero maxAmount revokes the allowance.
+    /// @param spender The address allowed to lock on behalf of the caller
+    /// @param maxAmount The total amount spender can lock or extend
+    /// @param maxUnlockDate The latest unlock date spender can lock or extend to (YYYY-MM-DD, RFC3339 timestamp or Unix seconds)
+    /// @return success Whether or not the approval was successful
+    function approve(
+        address spender,
+        uint256 maxAmount,
+        string memory maxUnlockDate
+    ) external returns (bool success);
+
+    /// @dev Remove the allowance of spender to lock the tokens of the caller.
+    /// @param spender The address whose allowance is removed
+    /// @return success Whether or not the revocation was successful
+    function revoke(address spender) external returns (bool success);
+
+    /// @dev Query the allowance of spender to lock the tokens of owner.
+    /// @param owner The address whose tokens can be locked
+    /// @param spender The address allowed to lock them
+    /// @return maxAmount The amount spender can still lock or extend, 0 without an allowance
+    /// @return maxUnlockDate The latest unlock date spender can lock or extend to
+    function allowance(
+        address owner,
+        address spender
+    ) external view returns (uint256 maxAmount, string memory maxUnlockDate);
+
     /// @dev Query locks for a specific address.
     /// @param lockAddress The address to query locks for
     /// @return locks An array of LockInfo representing the active locks
@@ -77,6 +195,32 @@ interface LockupI {
         address lockAddress
     ) external view returns (LockInfo[] memory locks);
 
+    /// @dev Query the active locks of several addresses.
+    /// @param accounts The addresses to query locks for
+    /// @return accountLocks The active locks of each address, in the order of accounts
+    function accountLocks(
+        address[] calldata accounts
+    ) external view returns (AccountLockInfo[] memory accountLocks);
+
+    /// @dev Query a page of the active locks of all accounts, ordered by unlock date.
+    /// @param pageKey The nextKey returned by the previous page, empty for the first page
+    /// @param limit The maximum number of locks to return, 0 for the default of 100
+    /// @return locks The active locks of the page
+    /// @return nextKey The key of the next page, empty after the last page
+    function activeLocks(
+        bytes calldata pageKey,
+        uint64 limit
+    ) external view returns (ActiveLockInfo[] memory locks, bytes memory nextKey);
+
+    /// @dev Query the amount an address has locked at the current block time,
+    /// including its lock schedules.
+    /// @param account The address to query the locked amount for
+    /// @return denom The bond denomination
+    /// @return locked The amount of tokens locked
+    function lockedAmountOf(
+        address account
+    ) external view returns (string memory denom, uint256 locked);
+
     /// @dev Query the total locked amount across all accounts.
     /// @return denom The bond denomination
     /// @return totalLocked The total amount of tokens locked
@@ -85,6 +229,26 @@ interface LockupI {
         view
         returns (string memory denom, uint256 totalLocked);
 
+    /// @dev Query the bond denom balance an account can send. The part of its
+    /// locks not covered by its delegations stays in its balance.
+    /// @param account The address to query the spendable balance for
+    /// @return denom The bond denomination
+    /// @return spendable The amount of tokens the account can send
+    function spendableBondBalance(
+        address account
+    ) external view returns (string memory denom, uint256 spendable);
+
+    /// @dev Query the amount a delegator can undelegate from a validator without
+    /// its delegations dropping below its locks.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddress The validator to undelegate from (bech32 format)
+    /// @return denom The bond denomination
+    /// @return amount The amount of tokens the delegator can undelegate
+    function maxUndelegatable(
+        address delegatorAddress,
+        string memory validatorAddress
+    ) external view returns (string memory denom, uint256 amount);
+
     /// @dev Lock defines an Event emitted when tokens are locked.
     /// @param lockAddress The address of the account locking tokens
     /// @param unlockDate The unlock date for the lock
@@ -120,4 +284,96 @@ interface LockupI {
         string unlockDate,
         uint256 amount
     );
+
+    /// @dev UnlockEarly defines an Event emitted when a lock is released before its unlock date.
+    /// @param lockAddress The address of the account releasing the lock
+    /// @param unlockDate The unlock date of the released lock
+    /// @param amount The amount of tokens released
+    /// @param penalty The amount charged as early unlock penalty
+    event UnlockEarly(
+        address indexed lockAddress,
+        string unlockDate,
+        uint256 amount,
+        uint256 penalty
+    );
+
+    /// @dev LockApproval defines an Event emitted when an allowance to lock is set.
+    /// @param owner The address whose tokens can be locked
+    /// @param spender The address allowed to lock them
+    /// @param maxAmount The amount spender can lock or extend
+    /// @param maxUnlockDate The latest unlock date spender can lock or extend to
+    event LockApproval(
+        address indexed owner,
+        address indexed spender,
+        uint256 maxAmount,
+        string maxUnlockDate
+    );
+
+    /// @dev LockTime is emitted next to Lock, with the unlock date in Unix seconds.
+    /// @param lockAddress The address of the account locking tokens
+    /// @param unlockTime The unlock date in Unix seconds
+    /// @param amount The amount of tokens locked
+    event LockTime(
+        address indexed lockAddress,
+        uint64 unlockTime,
+        uint256 amount
+    );
+
+    /// @dev LockExtendedTime is emitted next to LockExtended, with the unlock dates in Unix seconds.
+    /// @param lockAddress The address of the account extending the lock
+    /// @param oldUnlockTime The original unlock date in Unix seconds
+    /// @param newUnlockTime The new unlock date in Unix seconds
+    /// @param amount The amount of tokens in the extended lock
+    event LockExtendedTime(
+        address indexed lockAddress,
+        uint64 oldUnlockTime,
+        uint64 newUnlockTime,
+        uint256 amount
+    );
+
+    /// @dev SendDelegateAndLockTime is emitted next to SendDelegateAndLock, with the unlock date in Unix seconds.
+    /// @param fromAddress The sender address
+    /// @param toAddress The recipient address
+    /// @param validatorAddress The validator the tokens are delegated to
+    /// @param unlockTime The unlock date in Unix seconds
+    /// @param amount The amount of tokens
+    event SendDelegateAndLockTime(
+        address indexed fromAddress,
+        address indexed toAddress,
+        string validatorAddress,
+        uint64 unlockTime,
+        uint256 amount
+    );
+
+    /// @dev UnlockEarlyTime is emitted next to UnlockEarly, with the unlock date in Unix seconds.
+    /// @param lockAddress The address of the account releasing the lock
+    /// @param unlockTime The unlock date of the released lock in Unix seconds
+    /// @param amount The amount of tokens released
+    /// @param penalty The amount charged as early unlock penalty
+    event UnlockEarlyTime(
+        address indexed lockAddress,
+        uint64 unlockTime,
+        uint256 amount,
+        uint256 penalty
+    );
+
+    /// @dev LockApprovalTime is emitted next to LockApproval, with the max unlock date in Unix seconds.
+    /// @param owner The address whose tokens can be locked
+    /// @param spender The address allowed to lock them
+    /// @param maxAmount The amount spender can lock or extend
+    /// @param maxUnlockTime The latest unlock date spender can lock or extend to, in Unix seconds
+    event LockApprovalTime(
+        address indexed owner,
+        address indexed spender,
+        uint256 maxAmount,
+        uint64 maxUnlockTime
+    );
+
+    /// @dev LockRevocation defines an Event emitted when an allowance to lock is removed.
+    /// @param owner The address whose tokens could be locked
+    /// @param spender The address that was allowed to lock them
+    event LockRevocation(
+        address indexed owner,
+        address indexed spender
+    );
 }
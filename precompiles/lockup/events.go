@@ -10,6 +10,8 @@ import (
 
 	cmn "github.com/cosmos/evm/precompiles/common"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
@@ -22,6 +24,26 @@ const (
 	EventTypeLockExtended = "LockExtended"
 	// EventTypeSendDelegateAndLock defines the event type for the lockup SendDelegateAndLock transaction.
 	EventTypeSendDelegateAndLock = "SendDelegateAndLock"
+	// EventTypeUnlockEarly defines the event type for the lockup UnlockEarly transaction.
+	EventTypeUnlockEarly = "UnlockEarly"
+	// EventTypeLockApproval defines the event type for the lockup allowance approval transaction.
+	EventTypeLockApproval = "LockApproval"
+	// EventTypeLockRevocation defines the event type for the lockup allowance revocation transaction.
+	EventTypeLockRevocation = "LockRevocation"
+
+	// The Time events are emitted next to the events above, with the unlock
+	// dates in Unix seconds instead of strings.
+
+	// EventTypeLockTime defines the numeric event type for the lockup Lock transaction.
+	EventTypeLockTime = "LockTime"
+	// EventTypeLockExtendedTime defines the numeric event type for the lockup LockExtended transaction.
+	EventTypeLockExtendedTime = "LockExtendedTime"
+	// EventTypeSendDelegateAndLockTime defines the numeric event type for the lockup SendDelegateAndLock transaction.
+	EventTypeSendDelegateAndLockTime = "SendDelegateAndLockTime"
+	// EventTypeUnlockEarlyTime defines the numeric event type for the lockup UnlockEarly transaction.
+	EventTypeUnlockEarlyTime = "UnlockEarlyTime"
+	// EventTypeLockApprovalTime defines the numeric event type for the lockup allowance approval transaction.
+	EventTypeLockApprovalTime = "LockApprovalTime"
 )
 
 // EventLock defines the event data for the lockup Lock transaction.
@@ -48,7 +70,89 @@ type EventSendDelegateAndLock struct {
 	Amount           *big.Int
 }
 
-// EmitLockEvent creates a new event emitted on a Lock transaction.
+// EventUnlockEarly defines the event data for the lockup UnlockEarly transaction.
+type EventUnlockEarly struct {
+	LockAddress common.Address
+	UnlockDate  string
+	Amount      *big.Int
+	Penalty     *big.Int
+}
+
+// EventLockApproval defines the event data for the lockup allowance approval transaction.
+type EventLockApproval struct {
+	Owner         common.Address
+	Spender       common.Address
+	MaxAmount     *big.Int
+	MaxUnlockDate string
+}
+
+// EventLockRevocation defines the event data for the lockup allowance revocation transaction.
+type EventLockRevocation struct {
+	Owner   common.Address
+	Spender common.Address
+}
+
+// EventLockTime defines the numeric event data for the lockup Lock transaction.
+type EventLockTime struct {
+	LockAddress common.Address
+	UnlockTime  uint64
+	Amount      *big.Int
+}
+
+// EventLockExtendedTime defines the numeric event data for the lockup LockExtended transaction.
+type EventLockExtendedTime struct {
+	LockAddress   common.Address
+	OldUnlockTime uint64
+	NewUnlockTime uint64
+	Amount        *big.Int
+}
+
+// EventSendDelegateAndLockTime defines the numeric event data for the lockup SendDelegateAndLock transaction.
+type EventSendDelegateAndLockTime struct {
+	FromAddress      common.Address
+	ToAddress        common.Address
+	ValidatorAddress string
+	UnlockTime       uint64
+	Amount           *big.Int
+}
+
+// EventUnlockEarlyTime defines the numeric event data for the lockup UnlockEarly transaction.
+type EventUnlockEarlyTime struct {
+	LockAddress common.Address
+	UnlockTime  uint64
+	Amount      *big.Int
+	Penalty     *big.Int
+}
+
+// EventLockApprovalTime defines the numeric event data for the lockup allowance approval transaction.
+type EventLockApprovalTime struct {
+	Owner         common.Address
+	Spender       common.Address
+	MaxAmount     *big.Int
+	MaxUnlockTime uint64
+}
+
+// unlockTimestamp returns an unlock date in Unix seconds, for the Time events.
+func unlockTimestamp(unlockDate string) (uint64, error) {
+	unlockTime, err := lockuptypes.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(unlockTime.Unix()), nil //nolint:gosec // G115 -- not before the Unix epoch
+}
+
+// addLog adds a log of the precompile with the given topics and data.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+}
+
+// EmitLockEvent creates the Lock and LockTime events emitted on a Lock transaction.
 func (p Precompile) EmitLockEvent(ctx sdk.Context, stateDB vm.StateDB, msg *lockuptypes.MsgLock, lockAddr common.Address) error {
 	// Prepare the event topics
 	event := p.Events[EventTypeLock]
@@ -70,17 +174,26 @@ func (p Precompile) EmitLockEvent(ctx sdk.Context, stateDB vm.StateDB, msg *lock
 		return err
 	}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
-	})
+	p.addLog(ctx, stateDB, topics, packed)
+
+	unlockTime, err := unlockTimestamp(msg.UnlockDate)
+	if err != nil {
+		return err
+	}
+
+	timeEvent := p.Events[EventTypeLockTime]
+	arguments = abi.Arguments{timeEvent.Inputs[1], timeEvent.Inputs[2]}
+	packed, err = arguments.Pack(unlockTime, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, []common.Hash{timeEvent.ID, topics[1]}, packed)
 
 	return nil
 }
 
-// EmitLockExtendedEvent creates a new event emitted on an Extend transaction.
+// EmitLockExtendedEvent creates the LockExtended and LockExtendedTime events emitted on an Extend transaction.
 func (p Precompile) EmitLockExtendedEvent(ctx sdk.Context, stateDB vm.StateDB, address string, ext *lockuptypes.Extension, lockAddr common.Address) error {
 	// Prepare the event topics
 	event := p.Events[EventTypeLockExtended]
@@ -102,17 +215,32 @@ func (p Precompile) EmitLockExtendedEvent(ctx sdk.Context, stateDB vm.StateDB, a
 		return err
 	}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
-	})
+	p.addLog(ctx, stateDB, topics, packed)
+
+	oldUnlockTime, err := unlockTimestamp(ext.FromDate)
+	if err != nil {
+		return err
+	}
+
+	newUnlockTime, err := unlockTimestamp(ext.ToDate)
+	if err != nil {
+		return err
+	}
+
+	timeEvent := p.Events[EventTypeLockExtendedTime]
+	arguments = abi.Arguments{timeEvent.Inputs[1], timeEvent.Inputs[2], timeEvent.Inputs[3]}
+	packed, err = arguments.Pack(oldUnlockTime, newUnlockTime, ext.Amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, []common.Hash{timeEvent.ID, topics[1]}, packed)
 
 	return nil
 }
 
-// EmitSendDelegateAndLockEvent creates a new event emitted on a SendDelegateAndLock transaction.
+// EmitSendDelegateAndLockEvent creates the SendDelegateAndLock and SendDelegateAndLockTime events emitted on a
+// SendDelegateAndLock transaction.
 func (p Precompile) EmitSendDelegateAndLockEvent(ctx sdk.Context, stateDB vm.StateDB, msg *lockuptypes.MsgSendDelegateAndLock, fromAddr, toAddr common.Address) error {
 	// Prepare the event topics
 	event := p.Events[EventTypeSendDelegateAndLock]
@@ -139,12 +267,134 @@ func (p Precompile) EmitSendDelegateAndLockEvent(ctx sdk.Context, stateDB vm.Sta
 		return err
 	}
 
-	stateDB.AddLog(&ethtypes.Log{
-		Address:     p.Address(),
-		Topics:      topics,
-		Data:        packed,
-		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
-	})
+	p.addLog(ctx, stateDB, topics, packed)
+
+	unlockTime, err := unlockTimestamp(msg.UnlockDate)
+	if err != nil {
+		return err
+	}
+
+	timeEvent := p.Events[EventTypeSendDelegateAndLockTime]
+	arguments = abi.Arguments{timeEvent.Inputs[2], timeEvent.Inputs[3], timeEvent.Inputs[4]}
+	packed, err = arguments.Pack(msg.ValidatorAddress, unlockTime, msg.Amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, []common.Hash{timeEvent.ID, topics[1], topics[2]}, packed)
+
+	return nil
+}
+
+// EmitUnlockEarlyEvent creates the UnlockEarly and UnlockEarlyTime events emitted on an UnlockEarly transaction.
+func (p Precompile) EmitUnlockEarlyEvent(ctx sdk.Context, stateDB vm.StateDB, msg *lockuptypes.MsgUnlockEarly, penalty sdkmath.Int, lockAddr common.Address) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeUnlockEarly]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(lockAddr)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(msg.UnlockDate, msg.Amount.Amount.BigInt(), penalty.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+
+	unlockTime, err := unlockTimestamp(msg.UnlockDate)
+	if err != nil {
+		return err
+	}
+
+	timeEvent := p.Events[EventTypeUnlockEarlyTime]
+	arguments = abi.Arguments{timeEvent.Inputs[1], timeEvent.Inputs[2], timeEvent.Inputs[3]}
+	packed, err = arguments.Pack(unlockTime, msg.Amount.Amount.BigInt(), penalty.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, []common.Hash{timeEvent.ID, topics[1]}, packed)
+
+	return nil
+}
+
+// EmitLockApprovalEvent creates the LockApproval and LockApprovalTime events emitted on an allowance approval
+// transaction.
+func (p Precompile) EmitLockApprovalEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address, maxAmount sdkmath.Int, maxUnlockDate string) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeLockApproval]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(spender)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(maxAmount.BigInt(), maxUnlockDate)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+
+	maxUnlockTime, err := unlockTimestamp(maxUnlockDate)
+	if err != nil {
+		return err
+	}
+
+	timeEvent := p.Events[EventTypeLockApprovalTime]
+	arguments = abi.Arguments{timeEvent.Inputs[2], timeEvent.Inputs[3]}
+	packed, err = arguments.Pack(maxAmount.BigInt(), maxUnlockTime)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, []common.Hash{timeEvent.ID, topics[1], topics[2]}, packed)
+
+	return nil
+}
+
+// EmitLockRevocationEvent creates a new event emitted on an allowance revocation transaction.
+func (p Precompile) EmitLockRevocationEvent(ctx sdk.Context, stateDB vm.StateDB, owner, spender common.Address) error {
+	// Prepare the event topics
+	event := p.Events[EventTypeLockRevocation]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(spender)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, []byte{})
 
 	return nil
 }
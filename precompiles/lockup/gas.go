@@ -0,0 +1,106 @@
+package lockup
+
+import (
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Gas schedule
+//
+// cmn.Precompile.RequiredGas only prices the input bytes of a call. On top of
+// it, the PrecompileGas lockup param sets the gas of each method, plus a
+// surcharge for each extension or output of a call and for each lock a query
+// returns. RequiredGas has no access to the params, so Execute charges the
+// method gas and the input surcharges before the call does any work, so that
+// a call without enough gas fails before changing any state. A paginated
+// query also checks that the gas left covers the results of a full page
+// before reading it.
+
+// consumeMethodGas charges the gas of the schedule for a call of method with
+// args
+func (p Precompile) consumeMethodGas(ctx sdk.Context, method *abi.Method, args []interface{}) error {
+	params, err := p.lockupKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(methodGas(params.PrecompileGas, method.Name, args), "lockup precompile "+method.Name)
+
+	return nil
+}
+
+// consumeResultGas charges the per result gas of the schedule for each of
+// results locks
+func (p Precompile) consumeResultGas(ctx sdk.Context, results int) error {
+	params, err := p.lockupKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(params.PrecompileGas.PerResultGas*uint64(results), "lockup precompile query results") //nolint:gosec // G115
+
+	return nil
+}
+
+// requireResultGas checks that the gas left covers the per result gas of the
+// schedule for limit locks
+func (p Precompile) requireResultGas(ctx sdk.Context, limit uint64) error {
+	params, err := p.lockupKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	perResultGas := params.PrecompileGas.PerResultGas
+	if perResultGas == 0 {
+		return nil
+	}
+
+	remaining := ctx.GasMeter().GasRemaining()
+	if limit > remaining/perResultGas {
+		return vm.ErrOutOfGas
+	}
+
+	return nil
+}
+
+// methodGas returns the gas of the schedule for a call of method with args.
+// The per element gas is bounded by MaxPrecompileGas and the number of
+// elements by the size of the input, so the sum cannot overflow.
+func methodGas(schedule lockuptypes.PrecompileGas, method string, args []interface{}) uint64 {
+	switch method {
+	case LockMethod, LockUntilMethod:
+		return schedule.LockGas
+	case ExtendMethod, ExtendToMethod:
+		return schedule.ExtendGas + schedule.PerExtensionGas*argLen(args, 1)
+	case SendDelegateAndLockMethod, SendDelegateAndLockUntilMethod:
+		return schedule.SendDelegateAndLockGas
+	case MultiSendDelegateAndLockMethod:
+		return schedule.MultiSendDelegateAndLockGas + schedule.PerOutputGas*argLen(args, 1)
+	case UnlockEarlyMethod:
+		return schedule.UnlockEarlyGas
+	case ApproveMethod, RevokeMethod:
+		return schedule.AllowanceGas
+	default:
+		return schedule.QueryGas
+	}
+}
+
+// argLen returns the number of elements of the array argument at index i
+func argLen(args []interface{}, i int) uint64 {
+	if i >= len(args) {
+		return 0
+	}
+
+	v := reflect.ValueOf(args[i])
+	if v.Kind() != reflect.Slice {
+		return 0
+	}
+
+	return uint64(v.Len())
+}
@@ -0,0 +1,128 @@
+package lockup
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestMethodGas(t *testing.T) {
+	schedule := lockuptypes.DefaultPrecompileGas
+	extensions := make([]struct{ Amount *big.Int }, 3)
+	outputs := make([]struct{ Amount *big.Int }, 2)
+
+	tests := []struct {
+		method string
+		args   []interface{}
+		gas    uint64
+	}{
+		{LockMethod, nil, schedule.LockGas},
+		{LockUntilMethod, nil, schedule.LockGas},
+		{ExtendMethod, []interface{}{nil, extensions}, schedule.ExtendGas + 3*schedule.PerExtensionGas},
+		{ExtendToMethod, []interface{}{nil, extensions}, schedule.ExtendGas + 3*schedule.PerExtensionGas},
+		{SendDelegateAndLockMethod, nil, schedule.SendDelegateAndLockGas},
+		{SendDelegateAndLockUntilMethod, nil, schedule.SendDelegateAndLockGas},
+		{MultiSendDelegateAndLockMethod, []interface{}{big.NewInt(2), outputs}, schedule.MultiSendDelegateAndLockGas + 2*schedule.PerOutputGas},
+		{UnlockEarlyMethod, nil, schedule.UnlockEarlyGas},
+		{ApproveMethod, nil, schedule.AllowanceGas},
+		{RevokeMethod, nil, schedule.AllowanceGas},
+		{LocksMethod, nil, schedule.QueryGas},
+		{ActiveLocksMethod, nil, schedule.QueryGas},
+		// a malformed array argument is not charged per element
+		{ExtendMethod, []interface{}{nil, "extensions"}, schedule.ExtendGas},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method, func(t *testing.T) {
+			require.Equal(t, tc.gas, methodGas(schedule, tc.method, tc.args))
+		})
+	}
+}
+
+func TestExecuteChargesMethodGas(t *testing.T) {
+	f := SetupTest(t)
+
+	owner := hexAddr(f.addrs[0])
+	spender := hexAddr(f.addrs[1])
+	maxUnlockDate := "2026-06-01"
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	params, err := f.k.GetParams(ctx)
+	require.NoError(t, err)
+	params.PrecompileGas.AllowanceGas = 500_000
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	// the method gas is charged before the allowance is stored
+	_, err = f.call(t, ctx, &logDB{}, owner, 400_000, ApproveMethod, spender, big.NewInt(100), maxUnlockDate)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+
+	_, found, err := f.k.GetLockAllowance(ctx, f.addrs[0], f.addrs[1])
+	require.NoError(t, err)
+	require.False(t, found)
+
+	input, err := f.precompile.Pack(ApproveMethod, spender, big.NewInt(100), maxUnlockDate)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(owner, f.precompile.Address(), nil, 1_000_000, nil)
+	contract.Input = input
+
+	gasCtx := ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	_, err = f.precompile.Execute(gasCtx, &logDB{}, contract, false)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, gasCtx.GasMeter().GasConsumed(), params.PrecompileGas.AllowanceGas)
+
+	allowance, found, err := f.k.GetLockAllowance(ctx, f.addrs[0], f.addrs[1])
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), allowance.MaxAmount)
+}
+
+func TestActiveLocksResultGas(t *testing.T) {
+	f := SetupTest(t)
+
+	addrs := f.addrs[:3]
+	genState := lockuptypes.DefaultGenesis()
+	for _, addr := range addrs {
+		genState.AccountLocks = append(genState.AccountLocks, lockuptypes.AccountLocks{
+			Address: addr.String(),
+			Locks:   []*lockuptypes.Lock{{UnlockDate: "2026-06-01", Amount: math.NewInt(100)}},
+		})
+		genState.ExpirationQueue = append(genState.ExpirationQueue, lockuptypes.ExpirationQueueEntry{
+			UnlockDate: "2026-06-01", Address: addr.String(), Amount: math.NewInt(100),
+		})
+	}
+	require.NoError(t, f.k.InitGenesis(f.ctx, genState))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	schedule := lockuptypes.DefaultPrecompileGas
+	caller := hexAddr(f.addrs[0])
+
+	// the gas left must cover a full page before it is read
+	_, err := f.call(t, ctx, &logDB{}, caller, schedule.QueryGas+10*schedule.PerResultGas, ActiveLocksMethod, []byte{}, uint64(1_000))
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+
+	// a page of 2 of the 3 locks is charged per lock returned
+	input, err := f.precompile.Pack(ActiveLocksMethod, []byte{}, uint64(2))
+	require.NoError(t, err)
+
+	contract := vm.NewContract(caller, f.precompile.Address(), nil, 1_000_000, nil)
+	contract.Input = input
+
+	gasCtx := ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	bz, err := f.precompile.Execute(gasCtx, &logDB{}, contract, true)
+	require.NoError(t, err)
+
+	out, err := f.precompile.Unpack(ActiveLocksMethod, bz)
+	require.NoError(t, err)
+	require.Len(t, out[0], 2)
+	require.NotEmpty(t, out[1])
+	require.GreaterOrEqual(t, gasCtx.GasMeter().GasConsumed(), schedule.QueryGas+2*schedule.PerResultGas)
+}
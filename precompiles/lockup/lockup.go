@@ -89,6 +89,8 @@ func (p Precompile) Logger(ctx sdk.Context) log.Logger {
 }
 
 // RequiredGas returns the required bare minimum gas to execute the precompile.
+// It is called without the chain state, so it cannot read the gas schedule of
+// the lockup params; Execute charges that schedule before doing any work.
 func (p Precompile) RequiredGas(input []byte) uint64 {
 	// NOTE: This check avoid panicking when trying to decode the method ID
 	if len(input) < 4 {
@@ -119,21 +121,45 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 		return nil, err
 	}
 
+	if err = p.consumeMethodGas(ctx, method, args); err != nil {
+		return nil, err
+	}
+
 	var bz []byte
 
 	switch method.Name {
 	// Lockup transactions
-	case LockMethod:
+	case LockMethod, LockUntilMethod:
 		bz, err = p.Lock(ctx, contract, stateDB, method, args)
-	case ExtendMethod:
+	case ExtendMethod, ExtendToMethod:
 		bz, err = p.Extend(ctx, contract, stateDB, method, args)
-	case SendDelegateAndLockMethod:
+	case SendDelegateAndLockMethod, SendDelegateAndLockUntilMethod:
 		bz, err = p.SendDelegateAndLock(ctx, contract, stateDB, method, args)
+	case MultiSendDelegateAndLockMethod:
+		bz, err = p.MultiSendDelegateAndLock(ctx, contract, stateDB, method, args)
+	case UnlockEarlyMethod:
+		bz, err = p.UnlockEarly(ctx, contract, stateDB, method, args)
+	case ApproveMethod:
+		bz, err = p.Approve(ctx, contract, stateDB, method, args)
+	case RevokeMethod:
+		bz, err = p.Revoke(ctx, contract, stateDB, method, args)
 	// Lockup queries
 	case LocksMethod:
 		bz, err = p.Locks(ctx, method, contract, args)
 	case TotalLockedAmountMethod:
 		bz, err = p.TotalLockedAmount(ctx, method, contract, args)
+	case SpendableBondBalanceMethod:
+		bz, err = p.SpendableBondBalance(ctx, method, contract, args)
+	case MaxUndelegatableMethod:
+		bz, err = p.MaxUndelegatable(ctx, method, contract, args)
+	case AccountLocksMethod:
+		bz, err = p.AccountLocks(ctx, method, contract, args)
+	case ActiveLocksMethod:
+		bz, err = p.ActiveLocks(ctx, method, contract, args)
+	case LockedAmountOfMethod:
+		bz, err = p.LockedAmountOf(ctx, method, contract, args)
+	case AllowanceMethod:
+		bz, err = p.Allowance(ctx, method, contract, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -148,7 +174,8 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 // IsTransaction checks if the given method name corresponds to a write operation.
 func (p Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case LockMethod, ExtendMethod, SendDelegateAndLockMethod:
+	case LockMethod, ExtendMethod, SendDelegateAndLockMethod, MultiSendDelegateAndLockMethod, UnlockEarlyMethod,
+		LockUntilMethod, ExtendToMethod, SendDelegateAndLockUntilMethod, ApproveMethod, RevokeMethod:
 		return true
 	default:
 		return false
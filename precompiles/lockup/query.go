@@ -11,6 +11,7 @@ import (
 	cmn "github.com/cosmos/evm/precompiles/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 )
@@ -20,6 +21,18 @@ const (
 	LocksMethod = "locks"
 	// TotalLockedAmountMethod defines the ABI method name for the lockup TotalLockedAmount query.
 	TotalLockedAmountMethod = "totalLockedAmount"
+	// SpendableBondBalanceMethod defines the ABI method name for the lockup SpendableBondBalance query.
+	SpendableBondBalanceMethod = "spendableBondBalance"
+	// MaxUndelegatableMethod defines the ABI method name for the lockup MaxUndelegatable query.
+	MaxUndelegatableMethod = "maxUndelegatable"
+	// AccountLocksMethod defines the ABI method name for the lockup AccountLocks query.
+	AccountLocksMethod = "accountLocks"
+	// ActiveLocksMethod defines the ABI method name for the lockup ActiveLocks query.
+	ActiveLocksMethod = "activeLocks"
+	// LockedAmountOfMethod defines the ABI method name for the lockup locked amount query.
+	LockedAmountOfMethod = "lockedAmountOf"
+	// AllowanceMethod defines the ABI method name for the lockup allowance query.
+	AllowanceMethod = "allowance"
 )
 
 // LockInfoOutput represents a lock entry returned to the EVM caller.
@@ -27,6 +40,22 @@ type LockInfoOutput struct {
 	UnlockDate string   `abi:"unlockDate"`
 	Denom      string   `abi:"denom"`
 	Amount     *big.Int `abi:"amount"`
+	UnlockTime uint64   `abi:"unlockTime"`
+}
+
+// AccountLockInfoOutput represents the active locks of an account returned to the EVM caller.
+type AccountLockInfoOutput struct {
+	Account common.Address   `abi:"account"`
+	Locks   []LockInfoOutput `abi:"locks"`
+}
+
+// ActiveLockInfoOutput represents an active lock of any account returned to the EVM caller.
+type ActiveLockInfoOutput struct {
+	Account    common.Address `abi:"account"`
+	UnlockDate string         `abi:"unlockDate"`
+	Denom      string         `abi:"denom"`
+	Amount     *big.Int       `abi:"amount"`
+	UnlockTime uint64         `abi:"unlockTime"`
 }
 
 // Locks returns the active locks for a specific address.
@@ -47,25 +76,238 @@ func (p Precompile) Locks(
 
 	bech32Addr := sdk.AccAddress(lockAddress.Bytes()).String()
 
-	// Query locks for the address
-	res, err := p.lockupQuerier.Locks(ctx, &lockuptypes.QueryLocksRequest{
-		Address: bech32Addr,
+	// Query locks for the address, page by page
+	var locks []LockInfoOutput
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := p.lockupQuerier.Locks(ctx, &lockuptypes.QueryLocksRequest{
+			Address:    bech32Addr,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		infos, err := lockInfos(res.Locks)
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, infos...)
+
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	if err := p.consumeResultGas(ctx, len(locks)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(locks)
+}
+
+// AccountLocks returns the active locks for several addresses.
+func (p Precompile) AccountLocks(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	accounts, ok := args[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid account addresses: %v", args[0])
+	}
+
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = sdk.AccAddress(account.Bytes()).String()
+	}
+
+	res, err := p.lockupQuerier.AccountLocksBatch(ctx, &lockuptypes.QueryAccountLocksBatchRequest{
+		Addresses:  addresses,
+		Pagination: &query.PageRequest{Limit: uint64(len(addresses))},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Convert the response to the output format
-	locks := make([]LockInfoOutput, len(res.Locks))
+	results := 0
+	accountLocks := make([]AccountLockInfoOutput, len(res.Accounts))
+	for i, account := range res.Accounts {
+		infos, err := lockInfos(account.Locks)
+		if err != nil {
+			return nil, err
+		}
+
+		accountLocks[i] = AccountLockInfoOutput{
+			Account: accounts[i],
+			Locks:   infos,
+		}
+		results += len(account.Locks)
+	}
+
+	if err = p.consumeResultGas(ctx, results); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(accountLocks)
+}
+
+// ActiveLocks returns a page of the active locks of all accounts, ordered by
+// unlock date, and the key of the next page.
+func (p Precompile) ActiveLocks(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	pageKey, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid page key: %v", args[0])
+	}
+
+	limit, ok := args[1].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid limit: %v", args[1])
+	}
+
+	pageLimit := limit
+	if pageLimit == 0 {
+		pageLimit = query.DefaultLimit
+	}
+
+	if err := p.requireResultGas(ctx, pageLimit); err != nil {
+		return nil, err
+	}
+
+	res, err := p.lockupQuerier.ActiveLocks(ctx, &lockuptypes.QueryActiveLocksRequest{
+		Pagination: &query.PageRequest{Key: pageKey, Limit: limit},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make([]ActiveLockInfoOutput, len(res.Locks))
 	for i, lock := range res.Locks {
-		locks[i] = LockInfoOutput{
+		account, err := sdk.AccAddressFromBech32(lock.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		unlockTime, err := unlockTimestamp(lock.UnlockDate)
+		if err != nil {
+			return nil, err
+		}
+
+		locks[i] = ActiveLockInfoOutput{
+			Account:    common.BytesToAddress(account),
 			UnlockDate: lock.UnlockDate,
 			Denom:      lock.Amount.Denom,
 			Amount:     lock.Amount.Amount.BigInt(),
+			UnlockTime: unlockTime,
 		}
 	}
 
-	return method.Outputs.Pack(locks)
+	if err = p.consumeResultGas(ctx, len(locks)); err != nil {
+		return nil, err
+	}
+
+	nextKey := res.Pagination.NextKey
+	if nextKey == nil {
+		nextKey = []byte{}
+	}
+
+	return method.Outputs.Pack(locks, nextKey)
+}
+
+// LockedAmountOf returns the amount an address has locked at the current block time.
+func (p Precompile) LockedAmountOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := p.lockupKeeper.GetLockedAmountByAddress(ctx, sdk.AccAddress(account.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(bondDenom, locked.BigInt())
+}
+
+// Allowance returns the allowance of a spender to lock the tokens of an owner.
+func (p Precompile) Allowance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid owner address: %v", args[0])
+	}
+
+	spender, ok := args[1].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid spender address: %v", args[1])
+	}
+
+	allowance, found, err := p.lockupKeeper.GetLockAllowance(ctx, sdk.AccAddress(owner.Bytes()), sdk.AccAddress(spender.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return method.Outputs.Pack(big.NewInt(0), "")
+	}
+
+	return method.Outputs.Pack(allowance.MaxAmount.BigInt(), allowance.MaxUnlockDate)
+}
+
+// lockInfos converts lock resources to the output format
+func lockInfos(locks []lockuptypes.LockResource) ([]LockInfoOutput, error) {
+	infos := make([]LockInfoOutput, len(locks))
+	for i, lock := range locks {
+		unlockTime, err := unlockTimestamp(lock.UnlockDate)
+		if err != nil {
+			return nil, err
+		}
+
+		infos[i] = LockInfoOutput{
+			UnlockDate: lock.UnlockDate,
+			Denom:      lock.Amount.Denom,
+			Amount:     lock.Amount.Amount.BigInt(),
+			UnlockTime: unlockTime,
+		}
+	}
+	return infos, nil
 }
 
 // TotalLockedAmount returns the total locked amount across all accounts.
@@ -87,3 +329,62 @@ func (p Precompile) TotalLockedAmount(
 
 	return method.Outputs.Pack(res.TotalLocked.Denom, res.TotalLocked.Amount.BigInt())
 }
+
+// SpendableBondBalance returns the bond denom balance an address can send.
+func (p Precompile) SpendableBondBalance(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid account address: %v", args[0])
+	}
+
+	res, err := p.lockupQuerier.SpendableBondBalance(ctx, &lockuptypes.QuerySpendableBondBalanceRequest{
+		Address: sdk.AccAddress(account.Bytes()).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Spendable.Denom, res.Spendable.Amount.BigInt())
+}
+
+// MaxUndelegatable returns the amount a delegator can undelegate from a
+// validator without its delegations dropping below its locks.
+func (p Precompile) MaxUndelegatable(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegator, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid delegator address: %v", args[0])
+	}
+
+	validatorAddress, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid validator address: %v", args[1])
+	}
+
+	res, err := p.lockupQuerier.MaxUndelegatable(ctx, &lockuptypes.QueryMaxUndelegatableRequest{
+		Address:          sdk.AccAddress(delegator.Bytes()).String(),
+		ValidatorAddress: validatorAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Amount.Denom, res.Amount.Amount.BigInt())
+}
@@ -0,0 +1,150 @@
+package lockup
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// setupLocks stores the locks of each address, as unlock date to amount
+func setupLocks(t *testing.T, f *testFixture, locks map[string]map[string]int64) {
+	t.Helper()
+
+	genState := lockuptypes.DefaultGenesis()
+	for addr, byDate := range locks {
+		accountLocks := lockuptypes.AccountLocks{Address: addr}
+		for unlockDate, amount := range byDate {
+			accountLocks.Locks = append(accountLocks.Locks, &lockuptypes.Lock{UnlockDate: unlockDate, Amount: math.NewInt(amount)})
+			genState.ExpirationQueue = append(genState.ExpirationQueue, lockuptypes.ExpirationQueueEntry{
+				UnlockDate: unlockDate, Address: addr, Amount: math.NewInt(amount),
+			})
+		}
+		genState.AccountLocks = append(genState.AccountLocks, accountLocks)
+	}
+	require.NoError(t, f.k.InitGenesis(f.ctx, genState))
+}
+
+func TestAccountLocks(t *testing.T) {
+	f := SetupTest(t)
+
+	setupLocks(t, f, map[string]map[string]int64{
+		f.addrs[0].String(): {"2026-06-01": 100, "2026-07-01": 200},
+		f.addrs[1].String(): {"2026-06-01": 300},
+	})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	accounts := []common.Address{hexAddr(f.addrs[0]), hexAddr(f.addrs[2]), hexAddr(f.addrs[1])}
+
+	out, err := f.call(t, ctx, &logDB{}, hexAddr(f.addrs[0]), 10_000_000, AccountLocksMethod, accounts)
+	require.NoError(t, err)
+
+	accountLocks := *abi.ConvertType(out[0], new([]AccountLockInfoOutput)).(*[]AccountLockInfoOutput)
+	require.Len(t, accountLocks, 3)
+
+	// the accounts are returned in the order they are asked for
+	require.Equal(t, accounts[0], accountLocks[0].Account)
+	require.Equal(t, []LockInfoOutput{
+		{UnlockDate: "2026-06-01", Denom: sdk.DefaultBondDenom, Amount: big.NewInt(100), UnlockTime: uint64(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC).Unix())},
+		{UnlockDate: "2026-07-01", Denom: sdk.DefaultBondDenom, Amount: big.NewInt(200), UnlockTime: uint64(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC).Unix())},
+	}, accountLocks[0].Locks)
+
+	require.Equal(t, accounts[1], accountLocks[1].Account)
+	require.Empty(t, accountLocks[1].Locks)
+
+	require.Equal(t, accounts[2], accountLocks[2].Account)
+	require.Len(t, accountLocks[2].Locks, 1)
+
+	// the gas scales with the number of locks returned
+	schedule := lockuptypes.DefaultPrecompileGas
+	_, err = f.call(t, ctx, &logDB{}, hexAddr(f.addrs[0]), schedule.QueryGas+schedule.PerResultGas, AccountLocksMethod, accounts)
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+}
+
+func TestActiveLocksPagination(t *testing.T) {
+	f := SetupTest(t)
+
+	setupLocks(t, f, map[string]map[string]int64{
+		f.addrs[0].String(): {"2026-06-01": 100, "2026-08-01": 200},
+		f.addrs[1].String(): {"2026-07-01": 300},
+		f.addrs[2].String(): {"2026-09-01": 400},
+	})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	caller := hexAddr(f.addrs[0])
+
+	// the pages walk all the active locks by unlock date
+	var locks []ActiveLockInfoOutput
+	pageKey := []byte{}
+	pages := 0
+	for {
+		out, err := f.call(t, ctx, &logDB{}, caller, 10_000_000, ActiveLocksMethod, pageKey, uint64(3))
+		require.NoError(t, err)
+
+		page := *abi.ConvertType(out[0], new([]ActiveLockInfoOutput)).(*[]ActiveLockInfoOutput)
+		require.LessOrEqual(t, len(page), 3)
+		locks = append(locks, page...)
+		pages++
+
+		pageKey = out[1].([]byte)
+		if len(pageKey) == 0 {
+			break
+		}
+	}
+
+	require.Equal(t, 2, pages)
+	require.Len(t, locks, 4)
+
+	expected := []struct {
+		account    sdk.AccAddress
+		unlockDate string
+		amount     int64
+	}{
+		{f.addrs[0], "2026-06-01", 100},
+		{f.addrs[1], "2026-07-01", 300},
+		{f.addrs[0], "2026-08-01", 200},
+		{f.addrs[2], "2026-09-01", 400},
+	}
+	for i, lock := range locks {
+		require.Equal(t, hexAddr(expected[i].account), lock.Account)
+		require.Equal(t, expected[i].unlockDate, lock.UnlockDate)
+		require.Equal(t, big.NewInt(expected[i].amount), lock.Amount)
+
+		unlockTime, err := lockuptypes.ParseUnlockTime(expected[i].unlockDate)
+		require.NoError(t, err)
+		require.Equal(t, uint64(unlockTime.Unix()), lock.UnlockTime)
+	}
+
+	// a page key outside the lock queue is rejected
+	_, err := f.call(t, ctx, &logDB{}, caller, 10_000_000, ActiveLocksMethod, []byte{0xff}, uint64(3))
+	require.Error(t, err)
+}
+
+func TestLockedAmountOf(t *testing.T) {
+	f := SetupTest(t)
+
+	setupLocks(t, f, map[string]map[string]int64{
+		f.addrs[0].String(): {"2026-06-01": 100, "2026-07-01": 200},
+	})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	out, err := f.call(t, ctx, &logDB{}, hexAddr(f.addrs[1]), 10_000_000, LockedAmountOfMethod, hexAddr(f.addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{sdk.DefaultBondDenom, big.NewInt(300)}, out)
+
+	out, err = f.call(t, ctx, &logDB{}, hexAddr(f.addrs[1]), 10_000_000, LockedAmountOfMethod, hexAddr(f.addrs[1]))
+	require.NoError(t, err)
+	require.Equal(t, sdk.DefaultBondDenom, out[0])
+	require.Zero(t, out[1].(*big.Int).Sign())
+}
@@ -0,0 +1,180 @@
+package lockup
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	lockupkeeper "github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+var maccPerms = map[string][]string{
+	authtypes.FeeCollectorName:     nil,
+	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	minttypes.ModuleName:           {authtypes.Minter},
+	govtypes.ModuleName:            {authtypes.Burner},
+	distrtypes.ModuleName:          nil,
+	lockuptypes.ModuleName:         {authtypes.Burner},
+}
+
+type testFixture struct {
+	ctx        sdk.Context
+	k          lockupkeeper.Keeper
+	precompile *Precompile
+
+	bankkeeper    bankkeeper.BaseKeeper
+	stakingKeeper *stakingkeeper.Keeper
+
+	addrs   []sdk.AccAddress
+	valAddr sdk.ValAddress
+}
+
+func SetupTest(t *testing.T) *testFixture {
+	t.Helper()
+	f := new(testFixture)
+
+	// The app package imports this one, so the addresses use the SDK prefixes.
+	cfg := sdk.GetConfig()
+
+	logger := log.NewTestLogger(t)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	lockuptypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	govModAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	f.addrs = simtestutil.CreateIncrementalAccounts(4)
+	f.valAddr = sdk.ValAddress(f.addrs[3])
+
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, distrtypes.StoreKey, lockuptypes.StoreKey)
+	f.ctx = sdk.NewContext(integration.CreateMultiStore(keys, logger), cmtproto.Header{}, false, logger).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		sdkaddress.NewBech32Codec(cfg.GetBech32AccountAddrPrefix()), cfg.GetBech32AccountAddrPrefix(),
+		govModAddr,
+	)
+
+	f.bankkeeper = bankkeeper.NewBaseKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		nil,
+		govModAddr, logger,
+	)
+
+	f.stakingKeeper = stakingkeeper.NewKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
+		accountKeeper, f.bankkeeper, govModAddr,
+		sdkaddress.NewBech32Codec(cfg.GetBech32ValidatorAddrPrefix()),
+		sdkaddress.NewBech32Codec(cfg.GetBech32ConsensusAddrPrefix()),
+	)
+	require.NoError(t, f.stakingKeeper.SetParams(f.ctx, stakingtypes.DefaultParams()))
+
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[distrtypes.StoreKey]),
+		accountKeeper, f.bankkeeper, f.stakingKeeper,
+		authtypes.FeeCollectorName, govModAddr,
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(f.ctx, distrtypes.InitialFeePool()))
+
+	f.k = lockupkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[lockuptypes.ModuleName]), logger, govModAddr, accountKeeper, f.bankkeeper, f.stakingKeeper, distrKeeper)
+	f.stakingKeeper.SetHooks(f.k.Hooks())
+	require.NoError(t, f.k.InitGenesis(f.ctx, lockuptypes.DefaultGenesis()))
+
+	f.precompile = NewPrecompile(f.k, lockupkeeper.NewMsgServerImpl(f.k), lockupkeeper.NewQuerier(f.k), f.stakingKeeper, f.bankkeeper)
+
+	require.NoError(t, f.stakingKeeper.SetValidator(f.ctx, stakingtypes.Validator{
+		OperatorAddress: f.valAddr.String(),
+		Status:          stakingtypes.Unbonded,
+		Tokens:          math.ZeroInt(),
+		DelegatorShares: math.LegacyZeroDec(),
+	}))
+
+	return f
+}
+
+// fund mints amount bond tokens to addr
+func (f *testFixture) fund(t *testing.T, addr sdk.AccAddress, amount int64) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, coins))
+}
+
+// call runs method of the precompile with args for caller, with gas for the
+// call, and returns the unpacked outputs. Running out of gas fails the call
+// with vm.ErrOutOfGas, as in the EVM.
+func (f *testFixture) call(t *testing.T, ctx sdk.Context, stateDB vm.StateDB, caller common.Address, gas uint64, method string, args ...interface{}) (out []interface{}, err error) {
+	t.Helper()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			out, err = nil, vm.ErrOutOfGas
+		}
+	}()
+
+	input, err := f.precompile.Pack(method, args...)
+	require.NoError(t, err)
+
+	contract := vm.NewContract(caller, f.precompile.Address(), nil, gas, nil)
+	contract.Input = input
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gas))
+	bz, err := f.precompile.Execute(ctx, stateDB, contract, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.precompile.Unpack(method, bz)
+}
+
+// logDB is the part of the state the precompile uses for its events
+type logDB struct {
+	vm.StateDB
+
+	logs []*ethtypes.Log
+}
+
+func (db *logDB) AddLog(log *ethtypes.Log) {
+	db.logs = append(db.logs, log)
+}
+
+// hexAddr returns the EVM address of addr
+func hexAddr(addr sdk.AccAddress) common.Address {
+	return common.BytesToAddress(addr)
+}
@@ -2,7 +2,9 @@ package lockup
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,6 +26,20 @@ const (
 	ExtendMethod = "extend"
 	// SendDelegateAndLockMethod defines the ABI method name for the lockup SendDelegateAndLock transaction.
 	SendDelegateAndLockMethod = "sendDelegateAndLock"
+	// MultiSendDelegateAndLockMethod defines the ABI method name for the lockup MultiSendDelegateAndLock transaction.
+	MultiSendDelegateAndLockMethod = "multiSendDelegateAndLock"
+	// UnlockEarlyMethod defines the ABI method name for the lockup UnlockEarly transaction.
+	UnlockEarlyMethod = "unlockEarly"
+	// LockUntilMethod defines the ABI method name for the lockup Lock transaction with a Unix unlock time.
+	LockUntilMethod = "lockUntil"
+	// ExtendToMethod defines the ABI method name for the lockup Extend transaction with Unix unlock times.
+	ExtendToMethod = "extendTo"
+	// SendDelegateAndLockUntilMethod defines the ABI method name for the lockup SendDelegateAndLock transaction with a Unix unlock time.
+	SendDelegateAndLockUntilMethod = "sendDelegateAndLockUntil"
+	// ApproveMethod defines the ABI method name for the lockup allowance approval transaction.
+	ApproveMethod = "approve"
+	// RevokeMethod defines the ABI method name for the lockup allowance revocation transaction.
+	RevokeMethod = "revoke"
 )
 
 // Lock performs a lock of tokens for a specific address until an unlock date.
@@ -55,9 +71,13 @@ func (p *Precompile) Lock(
 		),
 	)
 
-	msgSender := contract.Caller()
-	if msgSender != lockHexAddr {
-		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), lockHexAddr.String())
+	unlockTime, err := lockuptypes.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unlock date: %s", msg.UnlockDate)
+	}
+
+	if err = p.spendLockAllowance(ctx, contract.Caller(), lockHexAddr, msg.Amount.Amount, unlockTime); err != nil {
+		return nil, err
 	}
 
 	// Execute the transaction using the message server
@@ -101,9 +121,21 @@ func (p *Precompile) Extend(
 		),
 	)
 
-	msgSender := contract.Caller()
-	if msgSender != lockHexAddr {
-		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), lockHexAddr.String())
+	// An allowance is charged for each extension, and covers both the lock
+	// moved and its new unlock date.
+	for _, ext := range msg.Extensions {
+		fromTime, err := lockuptypes.ParseUnlockTime(ext.FromDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from date: %s", ext.FromDate)
+		}
+		toTime, err := lockuptypes.ParseUnlockTime(ext.ToDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to date: %s", ext.ToDate)
+		}
+
+		if err = p.spendLockExtensionAllowance(ctx, contract.Caller(), lockHexAddr, ext.Amount.Amount, fromTime, toTime); err != nil {
+			return nil, err
+		}
 	}
 
 	// Execute the transaction using the message server
@@ -165,8 +197,237 @@ func (p *Precompile) SendDelegateAndLock(
 	return method.Outputs.Pack(true)
 }
 
+// MultiSendDelegateAndLock sends tokens to several addresses, delegates them, and locks them.
+func (p *Precompile) MultiSendDelegateAndLock(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, fromHexAddr, toHexAddrs, err := NewMsgMultiSendDelegateAndLock(args, contract.Caller(), bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ from_address: %s, outputs_count: %d, total_amount: %s }",
+			fromHexAddr,
+			len(msg.Outputs),
+			msg.TotalAmount.Amount,
+		),
+	)
+
+	// Check the total up front, so that a batch the caller cannot fund fails
+	// before any of its outputs is processed.
+	spendable, err := p.lockupKeeper.GetSpendableBondBalance(ctx, sdk.AccAddress(fromHexAddr.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	if spendable.LT(msg.TotalAmount.Amount) {
+		return nil, fmt.Errorf("total amount %s exceeds spendable balance %s", msg.TotalAmount.Amount, spendable)
+	}
+
+	// Execute the transaction using the message server
+	if _, err = p.lockupMsgServer.MultiSendDelegateAndLock(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// Emit a send delegate and lock event for each output
+	for i, output := range msg.Outputs {
+		sendMsg := &lockuptypes.MsgSendDelegateAndLock{
+			FromAddress:      msg.FromAddress,
+			ToAddress:        output.ToAddress,
+			ValidatorAddress: output.ValidatorAddress,
+			UnlockDate:       output.UnlockDate,
+			Amount:           output.Amount,
+		}
+		if err = p.EmitSendDelegateAndLockEvent(ctx, stateDB, sendMsg, fromHexAddr, toHexAddrs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// UnlockEarly releases some or all of a lock before its unlock date.
+func (p *Precompile) UnlockEarly(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	bondDenom, err := p.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, lockHexAddr, err := NewMsgUnlockEarly(args, bondDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ lock_address: %s, unlock_date: %s, amount: %s }",
+			lockHexAddr,
+			msg.UnlockDate,
+			msg.Amount.Amount,
+		),
+	)
+
+	msgSender := contract.Caller()
+	if msgSender != lockHexAddr {
+		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), lockHexAddr.String())
+	}
+
+	// Execute the transaction using the message server
+	res, err := p.lockupMsgServer.UnlockEarly(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Emit the event for the unlock early transaction
+	if err = p.EmitUnlockEarlyEvent(ctx, stateDB, msg, res.Penalty.Amount, lockHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Penalty.Amount.BigInt())
+}
+
+// Approve allows a spender to lock and extend the tokens of the caller.
+func (p *Precompile) Approve(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	spender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid spender address: %v", args[0])
+	}
+
+	maxAmount, ok := args[1].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	maxUnlockDate, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid max unlock date: %v", args[2])
+	}
+
+	maxUnlockTime, err := lockuptypes.ParseUnlockTime(maxUnlockDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid max unlock date: %s", maxUnlockDate)
+	}
+
+	owner := contract.Caller()
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ owner: %s, spender: %s, max_amount: %s, max_unlock_date: %s }",
+			owner,
+			spender,
+			maxAmount,
+			maxUnlockDate,
+		),
+	)
+
+	if owner == spender {
+		return nil, fmt.Errorf("spender must not be the caller")
+	}
+
+	amt := sdkmath.NewIntFromBigInt(maxAmount)
+	if err = p.lockupKeeper.SetLockAllowance(ctx, sdk.AccAddress(owner.Bytes()), sdk.AccAddress(spender.Bytes()), amt, maxUnlockTime); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitLockApprovalEvent(ctx, stateDB, owner, spender, amt, lockuptypes.FormatUnlockTime(maxUnlockTime)); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the allowance of a spender to lock the tokens of the caller.
+func (p *Precompile) Revoke(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	spender, ok := args[0].(common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid spender address: %v", args[0])
+	}
+
+	owner := contract.Caller()
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ owner: %s, spender: %s }", owner, spender),
+	)
+
+	if err := p.lockupKeeper.RevokeLockAllowance(ctx, sdk.AccAddress(owner.Bytes()), sdk.AccAddress(spender.Bytes())); err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitLockRevocationEvent(ctx, stateDB, owner, spender); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// spendLockAllowance lets caller act on behalf of lockAddress: either it is
+// lockAddress itself, or amount until unlockTime is used from its allowance.
+func (p *Precompile) spendLockAllowance(ctx sdk.Context, caller, lockAddress common.Address, amount sdkmath.Int, unlockTime time.Time) error {
+	if caller == lockAddress {
+		return nil
+	}
+
+	return p.lockupKeeper.UseLockAllowance(ctx, sdk.AccAddress(lockAddress.Bytes()), sdk.AccAddress(caller.Bytes()), amount, unlockTime)
+}
+
+// spendLockExtensionAllowance lets caller move a lock of lockAddress from
+// fromTime to toTime: either it is lockAddress itself, or amount is used from
+// its allowance, which must cover both unlock dates.
+func (p *Precompile) spendLockExtensionAllowance(ctx sdk.Context, caller, lockAddress common.Address, amount sdkmath.Int, fromTime, toTime time.Time) error {
+	if caller == lockAddress {
+		return nil
+	}
+
+	return p.lockupKeeper.UseLockExtensionAllowance(ctx, sdk.AccAddress(lockAddress.Bytes()), sdk.AccAddress(caller.Bytes()), amount, fromTime, toTime)
+}
+
 // NewMsgLock creates a new MsgLock from the provided arguments.
-// args: [lockAddress (address), unlockDate (string), amount (uint256)]
+// args: [lockAddress (address), unlockDate (string) or unlockTime (uint64), amount (uint256)]
 func NewMsgLock(args []interface{}, bondDenom string) (*lockuptypes.MsgLock, common.Address, error) {
 	if len(args) != 3 {
 		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
@@ -177,9 +438,9 @@ func NewMsgLock(args []interface{}, bondDenom string) (*lockuptypes.MsgLock, com
 		return nil, common.Address{}, fmt.Errorf("invalid lock address: %v", args[0])
 	}
 
-	unlockDate, ok := args[1].(string)
-	if !ok {
-		return nil, common.Address{}, fmt.Errorf("invalid unlock date: %v", args[1])
+	unlockDate, err := unlockDateArg(args[1])
+	if err != nil {
+		return nil, common.Address{}, err
 	}
 
 	amount, ok := args[2].(*big.Int)
@@ -204,7 +465,7 @@ func NewMsgLock(args []interface{}, bondDenom string) (*lockuptypes.MsgLock, com
 }
 
 // NewMsgExtend creates a new MsgExtend from the provided arguments.
-// args: [lockAddress (address), extensions (LockExtension[])]
+// args: [lockAddress (address), extensions (LockExtension[] or LockExtensionTime[])]
 func NewMsgExtend(args []interface{}, bondDenom string) (*lockuptypes.MsgExtend, common.Address, error) {
 	if len(args) != 2 {
 		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
@@ -232,22 +493,50 @@ func NewMsgExtend(args []interface{}, bondDenom string) (*lockuptypes.MsgExtend,
 }
 
 // parseExtensions converts raw ABI extension data to lockup Extension types.
-// The ABI decodes tuple[] as a slice of anonymous structs matching the Solidity struct layout.
+// The ABI decodes tuple[] as a slice of anonymous structs matching the Solidity struct layout,
+// so the element types below must stay unnamed.
 // The bondDenom parameter overrides any user-supplied denom to prevent denom-confusion attacks.
 func parseExtensions(raw interface{}, bondDenom string) ([]*lockuptypes.Extension, error) {
-	type coinInput struct {
-		Denom  string   `abi:"denom"`
-		Amount *big.Int `abi:"amount"`
-	}
-
 	type extensionInput struct {
-		FromDate string    `abi:"fromDate"`
-		ToDate   string    `abi:"toDate"`
-		Amount   coinInput `abi:"amount"`
-	}
-
-	slice, ok := raw.([]extensionInput)
-	if !ok {
+		FromDate string
+		ToDate   string
+		Amount   *big.Int
+	}
+
+	var slice []extensionInput
+	switch raw := raw.(type) {
+	// LockExtension, with date strings
+	case []struct {
+		FromDate string `json:"fromDate"`
+		ToDate   string `json:"toDate"`
+		Amount   struct {
+			Denom  string   `json:"denom"`
+			Amount *big.Int `json:"amount"`
+		} `json:"amount"`
+	}:
+		slice = make([]extensionInput, len(raw))
+		for i, ext := range raw {
+			slice[i] = extensionInput{FromDate: ext.FromDate, ToDate: ext.ToDate, Amount: ext.Amount.Amount}
+		}
+	// LockExtensionTime, with Unix unlock times
+	case []struct {
+		FromTime uint64   `json:"fromTime"`
+		ToTime   uint64   `json:"toTime"`
+		Amount   *big.Int `json:"amount"`
+	}:
+		slice = make([]extensionInput, len(raw))
+		for i, ext := range raw {
+			fromDate, err := unlockDateArg(ext.FromTime)
+			if err != nil {
+				return nil, err
+			}
+			toDate, err := unlockDateArg(ext.ToTime)
+			if err != nil {
+				return nil, err
+			}
+			slice[i] = extensionInput{FromDate: fromDate, ToDate: toDate, Amount: ext.Amount}
+		}
+	default:
 		return nil, fmt.Errorf("invalid extensions type: %T", raw)
 	}
 
@@ -257,7 +546,7 @@ func parseExtensions(raw interface{}, bondDenom string) ([]*lockuptypes.Extensio
 
 	extensions := make([]*lockuptypes.Extension, len(slice))
 	for i, ext := range slice {
-		amt := sdkmath.NewIntFromBigInt(ext.Amount.Amount)
+		amt := sdkmath.NewIntFromBigInt(ext.Amount)
 		if !amt.IsPositive() {
 			return nil, fmt.Errorf("extension amount must be positive, got %s", amt.String())
 		}
@@ -273,9 +562,25 @@ func parseExtensions(raw interface{}, bondDenom string) ([]*lockuptypes.Extensio
 	return extensions, nil
 }
 
+// unlockDateArg returns the unlock date passed as a date string, or as Unix
+// seconds by the methods taking numeric timestamps.
+func unlockDateArg(arg interface{}) (string, error) {
+	switch arg := arg.(type) {
+	case string:
+		return arg, nil
+	case uint64:
+		if arg > math.MaxInt64 {
+			return "", fmt.Errorf("invalid unlock time: %d", arg)
+		}
+		return lockuptypes.FormatUnlockTime(time.Unix(int64(arg), 0)), nil
+	default:
+		return "", fmt.Errorf("invalid unlock date: %v", arg)
+	}
+}
+
 // NewMsgSendDelegateAndLock creates a new MsgSendDelegateAndLock from the provided arguments.
 // The fromAddress is derived from contract.Caller().
-// args: [toAddress (address), validatorAddress (string), unlockDate (string), amount (uint256)]
+// args: [toAddress (address), validatorAddress (string), unlockDate (string) or unlockTime (uint64), amount (uint256)]
 func NewMsgSendDelegateAndLock(args []interface{}, caller common.Address, bondDenom string) (*lockuptypes.MsgSendDelegateAndLock, common.Address, common.Address, error) {
 	if len(args) != 4 {
 		return nil, common.Address{}, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
@@ -291,9 +596,9 @@ func NewMsgSendDelegateAndLock(args []interface{}, caller common.Address, bondDe
 		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid validator address: %v", args[1])
 	}
 
-	unlockDate, ok := args[2].(string)
-	if !ok {
-		return nil, common.Address{}, common.Address{}, fmt.Errorf("invalid unlock date: %v", args[2])
+	unlockDate, err := unlockDateArg(args[2])
+	if err != nil {
+		return nil, common.Address{}, common.Address{}, err
 	}
 
 	amount, ok := args[3].(*big.Int)
@@ -319,3 +624,117 @@ func NewMsgSendDelegateAndLock(args []interface{}, caller common.Address, bondDe
 
 	return msg, caller, toAddress, nil
 }
+
+// NewMsgMultiSendDelegateAndLock creates a new MsgMultiSendDelegateAndLock from the provided arguments.
+// The fromAddress is derived from contract.Caller(); the message server checks
+// that the outputs add up to the total amount.
+// args: [totalAmount (uint256), outputs (Output[])]
+func NewMsgMultiSendDelegateAndLock(args []interface{}, caller common.Address, bondDenom string) (*lockuptypes.MsgMultiSendDelegateAndLock, common.Address, []common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	totalAmount, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidAmount, args[0])
+	}
+
+	total := sdkmath.NewIntFromBigInt(totalAmount)
+	if !total.IsPositive() {
+		return nil, common.Address{}, nil, fmt.Errorf("total amount must be positive")
+	}
+
+	fromBech32 := sdk.AccAddress(caller.Bytes()).String()
+
+	outputs, toAddresses, err := parseOutputs(args[1], fromBech32, bondDenom)
+	if err != nil {
+		return nil, common.Address{}, nil, fmt.Errorf("failed to parse outputs: %w", err)
+	}
+
+	msg := &lockuptypes.MsgMultiSendDelegateAndLock{
+		FromAddress: fromBech32,
+		TotalAmount: sdk.NewCoin(bondDenom, total),
+		Outputs:     outputs,
+	}
+
+	return msg, caller, toAddresses, nil
+}
+
+// parseOutputs converts raw ABI output data to lockup MultiSendDelegateAndLockOutput types,
+// together with the recipient of each output.
+// The ABI decodes tuple[] as a slice of anonymous structs tagged with the Solidity field names.
+// The bondDenom parameter is used for every output, as for the other transactions.
+func parseOutputs(raw interface{}, fromAddress, bondDenom string) ([]*lockuptypes.MultiSendDelegateAndLockOutput, []common.Address, error) {
+	// The element type must be unnamed to be identical to the decoded one.
+	slice, ok := raw.([]struct {
+		ToAddress        common.Address `json:"toAddress"`
+		ValidatorAddress string         `json:"validatorAddress"`
+		UnlockDate       string         `json:"unlockDate"`
+		Amount           *big.Int       `json:"amount"`
+	})
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid outputs type: %T", raw)
+	}
+
+	if len(slice) == 0 {
+		return nil, nil, fmt.Errorf("outputs must not be empty")
+	}
+
+	outputs := make([]*lockuptypes.MultiSendDelegateAndLockOutput, len(slice))
+	toAddresses := make([]common.Address, len(slice))
+	for i, out := range slice {
+		amt := sdkmath.NewIntFromBigInt(out.Amount)
+		if !amt.IsPositive() {
+			return nil, nil, fmt.Errorf("output amount must be positive, got %s", amt.String())
+		}
+
+		outputs[i] = &lockuptypes.MultiSendDelegateAndLockOutput{
+			FromAddress:      fromAddress,
+			ToAddress:        sdk.AccAddress(out.ToAddress.Bytes()).String(),
+			ValidatorAddress: out.ValidatorAddress,
+			UnlockDate:       out.UnlockDate,
+			Amount:           sdk.NewCoin(bondDenom, amt),
+		}
+		toAddresses[i] = out.ToAddress
+	}
+
+	return outputs, toAddresses, nil
+}
+
+// NewMsgUnlockEarly creates a new MsgUnlockEarly from the provided arguments.
+// args: [lockAddress (address), unlockDate (string), amount (uint256)]
+func NewMsgUnlockEarly(args []interface{}, bondDenom string) (*lockuptypes.MsgUnlockEarly, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	lockAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf("invalid lock address: %v", args[0])
+	}
+
+	unlockDate, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf("invalid unlock date: %v", args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[2])
+	}
+
+	amt := sdkmath.NewIntFromBigInt(amount)
+	if !amt.IsPositive() {
+		return nil, common.Address{}, fmt.Errorf("unlock amount must be positive")
+	}
+
+	bech32Addr := sdk.AccAddress(lockAddress.Bytes()).String()
+
+	msg := &lockuptypes.MsgUnlockEarly{
+		Address:    bech32Addr,
+		UnlockDate: unlockDate,
+		Amount:     sdk.NewCoin(bondDenom, amt),
+	}
+
+	return msg, lockAddress, nil
+}
@@ -0,0 +1,204 @@
+package lockup
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// output is the Output struct of the multiSendDelegateAndLock method
+type output struct {
+	ToAddress        common.Address
+	ValidatorAddress string
+	UnlockDate       string
+	Amount           *big.Int
+}
+
+func TestMultiSendDelegateAndLock(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	f.fund(t, from, 1000)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	outputs := []output{
+		{ToAddress: hexAddr(f.addrs[1]), ValidatorAddress: f.valAddr.String(), UnlockDate: "2026-06-01", Amount: big.NewInt(300)},
+		{ToAddress: hexAddr(f.addrs[2]), ValidatorAddress: f.valAddr.String(), UnlockDate: "2026-07-01", Amount: big.NewInt(200)},
+	}
+
+	// the outputs must add up to the total amount
+	_, err := f.call(t, ctx, &logDB{}, hexAddr(from), 10_000_000, MultiSendDelegateAndLockMethod, big.NewInt(600), outputs)
+	require.Error(t, err)
+
+	// the total must be spendable by the caller
+	_, err = f.call(t, ctx, &logDB{}, hexAddr(f.addrs[1]), 10_000_000, MultiSendDelegateAndLockMethod, big.NewInt(500), outputs)
+	require.ErrorContains(t, err, "exceeds spendable balance")
+
+	_, err = f.call(t, ctx, &logDB{}, hexAddr(from), 10_000_000, MultiSendDelegateAndLockMethod, big.NewInt(0), outputs)
+	require.Error(t, err)
+
+	stateDB := &logDB{}
+	out, err := f.call(t, ctx, stateDB, hexAddr(from), 10_000_000, MultiSendDelegateAndLockMethod, big.NewInt(500), outputs)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+
+	require.Equal(t, math.NewInt(500), f.bankkeeper.GetBalance(ctx, from, sdk.DefaultBondDenom).Amount)
+
+	for i, addr := range f.addrs[1:3] {
+		lock, found := f.k.GetLockByAddressAndDate(ctx, addr, outputs[i].UnlockDate)
+		require.True(t, found)
+		require.Equal(t, math.NewIntFromBigInt(outputs[i].Amount), lock.Amount)
+	}
+
+	// a SendDelegateAndLock event, and its Time event, for each output
+	event := f.precompile.Events[EventTypeSendDelegateAndLock]
+	require.Len(t, stateDB.logs, 4)
+	for i, log := range []int{0, 2} {
+		require.Equal(t, event.ID, stateDB.logs[log].Topics[0])
+		require.Equal(t, common.BytesToHash(hexAddr(from).Bytes()), stateDB.logs[log].Topics[1])
+		require.Equal(t, common.BytesToHash(outputs[i].ToAddress.Bytes()), stateDB.logs[log].Topics[2])
+
+		data, err := event.Inputs.NonIndexed().Unpack(stateDB.logs[log].Data)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{f.valAddr.String(), outputs[i].UnlockDate, outputs[i].Amount}, data)
+	}
+}
+
+// extensionTime is the LockExtensionTime struct of the extendTo method
+type extensionTime struct {
+	FromTime uint64
+	ToTime   uint64
+	Amount   *big.Int
+}
+
+// requireLog checks that log is the event of eventType of the precompile,
+// with the given indexed topics and data
+func requireLog(t *testing.T, f *testFixture, log *ethtypes.Log, eventType string, topics []common.Address, data ...interface{}) {
+	t.Helper()
+
+	event := f.precompile.Events[eventType]
+	require.Equal(t, f.precompile.Address(), log.Address)
+	require.Len(t, log.Topics, len(topics)+1)
+	require.Equal(t, event.ID, log.Topics[0])
+	for i, topic := range topics {
+		require.Equal(t, common.BytesToHash(topic.Bytes()), log.Topics[i+1])
+	}
+
+	unpacked, err := event.Inputs.NonIndexed().Unpack(log.Data)
+	require.NoError(t, err)
+	require.Len(t, unpacked, len(data))
+	if len(data) > 0 {
+		require.Equal(t, data, unpacked)
+	}
+}
+
+func TestUnixTimeMethods(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	f.fund(t, from, 1000)
+	f.fund(t, to, 200)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	june := uint64(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC).Unix())
+	july := uint64(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC).Unix())
+	august := uint64(time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC).Unix())
+
+	// a Unix time that reads as a date string is rejected
+	_, err := f.call(t, ctx, &logDB{}, hexAddr(from), 10_000_000, SendDelegateAndLockUntilMethod, hexAddr(to), f.valAddr.String(), uint64(20260601), big.NewInt(300))
+	require.Error(t, err)
+
+	stateDB := &logDB{}
+	_, err = f.call(t, ctx, stateDB, hexAddr(from), 10_000_000, SendDelegateAndLockUntilMethod, hexAddr(to), f.valAddr.String(), june, big.NewInt(300))
+	require.NoError(t, err)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, to, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(300), lock.Amount)
+
+	// the string event is kept, and followed by its Time event
+	require.Len(t, stateDB.logs, 2)
+	requireLog(t, f, stateDB.logs[0], EventTypeSendDelegateAndLock, []common.Address{hexAddr(from), hexAddr(to)}, f.valAddr.String(), "2026-06-01", big.NewInt(300))
+	requireLog(t, f, stateDB.logs[1], EventTypeSendDelegateAndLockTime, []common.Address{hexAddr(from), hexAddr(to)}, f.valAddr.String(), june, big.NewInt(300))
+
+	// the recipient delegates more of its own tokens and locks them
+	validator, err := f.stakingKeeper.GetValidator(ctx, f.valAddr)
+	require.NoError(t, err)
+	_, err = f.stakingKeeper.Delegate(ctx, to, math.NewInt(200), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	stateDB = &logDB{}
+	_, err = f.call(t, ctx, stateDB, hexAddr(to), 10_000_000, LockUntilMethod, hexAddr(to), july, big.NewInt(200))
+	require.NoError(t, err)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, to, "2026-07-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(200), lock.Amount)
+
+	require.Len(t, stateDB.logs, 2)
+	requireLog(t, f, stateDB.logs[0], EventTypeLock, []common.Address{hexAddr(to)}, "2026-07-01", big.NewInt(200))
+	requireLog(t, f, stateDB.logs[1], EventTypeLockTime, []common.Address{hexAddr(to)}, july, big.NewInt(200))
+
+	stateDB = &logDB{}
+	_, err = f.call(t, ctx, stateDB, hexAddr(to), 10_000_000, ExtendToMethod, hexAddr(to), []extensionTime{
+		{FromTime: june, ToTime: august, Amount: big.NewInt(100)},
+	})
+	require.NoError(t, err)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, to, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(200), lock.Amount)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, to, "2026-08-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), lock.Amount)
+
+	require.Len(t, stateDB.logs, 2)
+	requireLog(t, f, stateDB.logs[0], EventTypeLockExtended, []common.Address{hexAddr(to)}, "2026-06-01", "2026-08-01", big.NewInt(100))
+	requireLog(t, f, stateDB.logs[1], EventTypeLockExtendedTime, []common.Address{hexAddr(to)}, june, august, big.NewInt(100))
+
+	// the locks carry their Unix unlock time
+	out, err := f.call(t, ctx, &logDB{}, hexAddr(to), 10_000_000, LocksMethod, hexAddr(to))
+	require.NoError(t, err)
+
+	locks := *abi.ConvertType(out[0], new([]LockInfoOutput)).(*[]LockInfoOutput)
+	require.Equal(t, []LockInfoOutput{
+		{UnlockDate: "2026-06-01", Denom: sdk.DefaultBondDenom, Amount: big.NewInt(200), UnlockTime: june},
+		{UnlockDate: "2026-07-01", Denom: sdk.DefaultBondDenom, Amount: big.NewInt(200), UnlockTime: july},
+		{UnlockDate: "2026-08-01", Denom: sdk.DefaultBondDenom, Amount: big.NewInt(100), UnlockTime: august},
+	}, locks)
+}
+
+func TestApproveEvents(t *testing.T) {
+	f := SetupTest(t)
+
+	owner := hexAddr(f.addrs[0])
+	spender := hexAddr(f.addrs[1])
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	stateDB := &logDB{}
+	_, err := f.call(t, ctx, stateDB, owner, 10_000_000, ApproveMethod, spender, big.NewInt(100), "2026-06-01")
+	require.NoError(t, err)
+
+	require.Len(t, stateDB.logs, 2)
+	requireLog(t, f, stateDB.logs[0], EventTypeLockApproval, []common.Address{owner, spender}, big.NewInt(100), "2026-06-01")
+	requireLog(t, f, stateDB.logs[1], EventTypeLockApprovalTime, []common.Address{owner, spender}, big.NewInt(100), uint64(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC).Unix()))
+
+	stateDB = &logDB{}
+	_, err = f.call(t, ctx, stateDB, owner, 10_000_000, RevokeMethod, spender)
+	require.NoError(t, err)
+
+	require.Len(t, stateDB.logs, 1)
+	requireLog(t, f, stateDB.logs[0], EventTypeLockRevocation, []common.Address{owner, spender})
+}
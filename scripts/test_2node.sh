@@ -119,7 +119,7 @@ from_scratch() {
 
   # EVM
   update_genesis "$(printf '.app_state["evm"]["params"]["evm_denom"]="%s"' $DENOM)"
-  update_genesis '.app_state["evm"]["params"]["active_static_precompiles"]=["0x0000000000000000000000000000000000000100","0x0000000000000000000000000000000000000400","0x0000000000000000000000000000000000000800","0x0000000000000000000000000000000000000801","0x0000000000000000000000000000000000000802","0x0000000000000000000000000000000000000803","0x0000000000000000000000000000000000000804","0x0000000000000000000000000000000000000805","0x0000000000000000000000000000000000000900"]'
+  update_genesis '.app_state["evm"]["params"]["active_static_precompiles"]=["0x0000000000000000000000000000000000000100","0x0000000000000000000000000000000000000400","0x0000000000000000000000000000000000000800","0x0000000000000000000000000000000000000801","0x0000000000000000000000000000000000000802","0x0000000000000000000000000000000000000803","0x0000000000000000000000000000000000000804","0x0000000000000000000000000000000000000805","0x0000000000000000000000000000000000000900","0x0000000000000000000000000000000000000901"]'
   update_genesis '.app_state["feemarket"]["params"]["no_base_fee"]=true'
   update_genesis '.app_state["feemarket"]["params"]["base_fee"]="0.000000000000000000"'
 
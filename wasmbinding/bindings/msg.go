@@ -0,0 +1,48 @@
+package bindings
+
+import "cosmossdk.io/math"
+
+// TscMsg is the custom message a CosmWasm contract sends as
+// CosmosMsg::Custom. Exactly one of its fields is set.
+type TscMsg struct {
+	// Lock locks bond denom tokens until an unlock date.
+	Lock *Lock `json:"lock,omitempty"`
+	// Extend moves locked tokens to later unlock dates.
+	Extend *Extend `json:"extend,omitempty"`
+	// SendDelegateAndLock sends tokens of the contract to an address,
+	// delegates them to a validator and locks them.
+	SendDelegateAndLock *SendDelegateAndLock `json:"send_delegate_and_lock,omitempty"`
+}
+
+// Lock locks amount of the tokens of address until unlock date. An empty
+// address is the contract itself; another address must have given the
+// contract a lock allowance.
+type Lock struct {
+	Address    string   `json:"address,omitempty"`
+	UnlockDate string   `json:"unlock_date"`
+	Amount     math.Int `json:"amount"`
+}
+
+// Extend moves locked tokens of address to later unlock dates. An empty
+// address is the contract itself; another address must have given the
+// contract a lock allowance.
+type Extend struct {
+	Address    string      `json:"address,omitempty"`
+	Extensions []Extension `json:"extensions"`
+}
+
+// Extension moves amount from the lock unlocking at from date to to date.
+type Extension struct {
+	FromDate string   `json:"from_date"`
+	ToDate   string   `json:"to_date"`
+	Amount   math.Int `json:"amount"`
+}
+
+// SendDelegateAndLock sends amount from the contract to to address,
+// delegates it to validator address and locks it until unlock date.
+type SendDelegateAndLock struct {
+	ToAddress        string   `json:"to_address"`
+	ValidatorAddress string   `json:"validator_address"`
+	UnlockDate       string   `json:"unlock_date"`
+	Amount           math.Int `json:"amount"`
+}
@@ -0,0 +1,62 @@
+package bindings
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// TscQuery is the custom query a CosmWasm contract sends as
+// QueryRequest::Custom. Exactly one of its fields is set.
+type TscQuery struct {
+	// Locks returns the active locks of an address.
+	Locks *Locks `json:"locks,omitempty"`
+	// TotalLocked returns the amount locked across all accounts.
+	TotalLocked *TotalLocked `json:"total_locked,omitempty"`
+	// DistroParams returns the params of the distro module.
+	DistroParams *DistroParams `json:"distro_params,omitempty"`
+	// Spendable returns the bond denom balance an address can send given its
+	// locks.
+	Spendable *Spendable `json:"spendable,omitempty"`
+}
+
+type Locks struct {
+	Address string `json:"address"`
+}
+
+type TotalLocked struct{}
+
+type DistroParams struct{}
+
+type Spendable struct {
+	Address string `json:"address"`
+}
+
+// LocksResponse is the response of TscQuery::Locks.
+type LocksResponse struct {
+	Locks []LockInfo `json:"locks"`
+}
+
+// LockInfo is an active lock in a LocksResponse.
+type LockInfo struct {
+	UnlockDate string           `json:"unlock_date"`
+	Amount     wasmvmtypes.Coin `json:"amount"`
+}
+
+// TotalLockedResponse is the response of TscQuery::TotalLocked.
+type TotalLockedResponse struct {
+	TotalLocked wasmvmtypes.Coin `json:"total_locked"`
+}
+
+// DistroParamsResponse is the response of TscQuery::DistroParams.
+type DistroParamsResponse struct {
+	MintingAddress        string `json:"minting_address"`
+	ReceivingAddress      string `json:"receiving_address"`
+	Denom                 string `json:"denom"`
+	MaxSupply             string `json:"max_supply"`
+	DistributionStartDate string `json:"distribution_start_date"`
+	MonthsInHalvingPeriod uint64 `json:"months_in_halving_period"`
+}
+
+// SpendableResponse is the response of TscQuery::Spendable.
+type SpendableResponse struct {
+	Spendable wasmvmtypes.Coin `json:"spendable"`
+}
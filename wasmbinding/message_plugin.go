@@ -0,0 +1,230 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding/bindings"
+	lockupkeeper "github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// CustomMessageDecorator returns a decorator that handles TscMsg custom
+// messages and passes every other message on to the wrapped messenger.
+func CustomMessageDecorator(lockupKeeper lockupkeeper.Keeper, stakingKeeper lockuptypes.StakingKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:         old,
+			lockupKeeper:    lockupKeeper,
+			lockupMsgServer: lockupkeeper.NewMsgServerImpl(lockupKeeper),
+			stakingKeeper:   stakingKeeper,
+		}
+	}
+}
+
+// CustomMessenger dispatches TscMsg custom messages to the lockup message
+// server on behalf of the sending contract.
+type CustomMessenger struct {
+	wrapped         wasmkeeper.Messenger
+	lockupKeeper    lockupkeeper.Keeper
+	lockupMsgServer lockuptypes.MsgServer
+	stakingKeeper   lockuptypes.StakingKeeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes a TscMsg, or hands any other message to the wrapped
+// messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom == nil {
+		return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+
+	var tscMsg bindings.TscMsg
+	if err := json.Unmarshal(msg.Custom, &tscMsg); err != nil {
+		return nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, "tsc msg")
+	}
+
+	var (
+		res proto.Message
+		err error
+	)
+	switch {
+	case tscMsg.Lock != nil:
+		res, err = m.lock(ctx, contractAddr, tscMsg.Lock)
+	case tscMsg.Extend != nil:
+		res, err = m.extend(ctx, contractAddr, tscMsg.Extend)
+	case tscMsg.SendDelegateAndLock != nil:
+		res, err = m.sendDelegateAndLock(ctx, contractAddr, tscMsg.SendDelegateAndLock)
+	default:
+		return nil, nil, nil, errorsmod.Wrap(wasmtypes.ErrUnknownMsg, "unknown tsc msg variant")
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// The message servers emit their events on the context, which the wasm
+	// dispatcher collects. Only the response goes back to the contract, both
+	// as data and as a msg response, as for the SDK messages.
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resAny, err := codectypes.NewAnyWithValue(res)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return nil, [][]byte{data}, [][]*codectypes.Any{{resAny}}, nil
+}
+
+// lock performs a Lock and returns its response
+func (m *CustomMessenger) lock(ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.Lock) (proto.Message, error) {
+	lockAddr, err := m.lockAddress(contractAddr, lock.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := m.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &lockuptypes.MsgLock{
+		Address:    lockAddr.String(),
+		UnlockDate: lock.UnlockDate,
+		Amount:     sdk.Coin{Denom: bondDenom, Amount: lock.Amount},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	unlockTime, err := lockuptypes.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, errorsmod.Wrapf(lockuptypes.ErrInvalidDate, "invalid unlock date: %s", msg.UnlockDate)
+	}
+
+	if err := m.spendLockAllowance(ctx, contractAddr, lockAddr, msg.Amount.Amount, unlockTime); err != nil {
+		return nil, err
+	}
+
+	return m.lockupMsgServer.Lock(ctx, msg)
+}
+
+// extend performs an Extend and returns its response
+func (m *CustomMessenger) extend(ctx sdk.Context, contractAddr sdk.AccAddress, extend *bindings.Extend) (proto.Message, error) {
+	lockAddr, err := m.lockAddress(contractAddr, extend.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := m.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &lockuptypes.MsgExtend{
+		Address:    lockAddr.String(),
+		Extensions: make([]*lockuptypes.Extension, len(extend.Extensions)),
+	}
+	for i, ext := range extend.Extensions {
+		msg.Extensions[i] = &lockuptypes.Extension{
+			FromDate: ext.FromDate,
+			ToDate:   ext.ToDate,
+			Amount:   sdk.Coin{Denom: bondDenom, Amount: ext.Amount},
+		}
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// An allowance is charged for each extension, and covers both the lock
+	// moved and its new unlock date.
+	for _, ext := range msg.Extensions {
+		fromTime, err := lockuptypes.ParseUnlockTime(ext.FromDate)
+		if err != nil {
+			return nil, errorsmod.Wrapf(lockuptypes.ErrInvalidDate, "invalid from date: %s", ext.FromDate)
+		}
+		toTime, err := lockuptypes.ParseUnlockTime(ext.ToDate)
+		if err != nil {
+			return nil, errorsmod.Wrapf(lockuptypes.ErrInvalidDate, "invalid to date: %s", ext.ToDate)
+		}
+
+		if err := m.spendLockExtensionAllowance(ctx, contractAddr, lockAddr, ext.Amount.Amount, fromTime, toTime); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.lockupMsgServer.Extend(ctx, msg)
+}
+
+// sendDelegateAndLock performs a SendDelegateAndLock from the contract and
+// returns its response
+func (m *CustomMessenger) sendDelegateAndLock(ctx sdk.Context, contractAddr sdk.AccAddress, send *bindings.SendDelegateAndLock) (proto.Message, error) {
+	bondDenom, err := m.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &lockuptypes.MsgSendDelegateAndLock{
+		FromAddress:      contractAddr.String(),
+		ToAddress:        send.ToAddress,
+		ValidatorAddress: send.ValidatorAddress,
+		UnlockDate:       send.UnlockDate,
+		Amount:           sdk.Coin{Denom: bondDenom, Amount: send.Amount},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return m.lockupMsgServer.SendDelegateAndLock(ctx, msg)
+}
+
+// lockAddress returns the address a lock or extension is for, the contract
+// itself when address is empty
+func (m *CustomMessenger) lockAddress(contractAddr sdk.AccAddress, address string) (sdk.AccAddress, error) {
+	if address == "" {
+		return contractAddr, nil
+	}
+
+	lockAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock address: %s", address)
+	}
+
+	return lockAddr, nil
+}
+
+// spendLockAllowance lets the contract act on behalf of lockAddr: either it is
+// lockAddr itself, or amount until unlockTime is used from its allowance.
+func (m *CustomMessenger) spendLockAllowance(ctx sdk.Context, contractAddr, lockAddr sdk.AccAddress, amount math.Int, unlockTime time.Time) error {
+	if contractAddr.Equals(lockAddr) {
+		return nil
+	}
+
+	return m.lockupKeeper.UseLockAllowance(ctx, lockAddr, contractAddr, amount, unlockTime)
+}
+
+// spendLockExtensionAllowance lets the contract move a lock of lockAddr from
+// fromTime to toTime: either it is lockAddr itself, or amount is used from its
+// allowance, which must cover both unlock dates.
+func (m *CustomMessenger) spendLockExtensionAllowance(ctx sdk.Context, contractAddr, lockAddr sdk.AccAddress, amount math.Int, fromTime, toTime time.Time) error {
+	if contractAddr.Equals(lockAddr) {
+		return nil
+	}
+
+	return m.lockupKeeper.UseLockExtensionAllowance(ctx, lockAddr, contractAddr, amount, fromTime, toTime)
+}
@@ -0,0 +1,173 @@
+package wasmbinding
+
+import (
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding/bindings"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// dispatch sends msg as a custom message of contract, and checks that the
+// contract gets res back as data and as msg response
+func dispatch(t *testing.T, f *testFixture, ctx sdk.Context, contract sdk.AccAddress, msg bindings.TscMsg, res proto.Message) error {
+	t.Helper()
+
+	events, data, msgResponses, err := f.messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: custom(t, msg)})
+	if err != nil {
+		return err
+	}
+
+	require.Empty(t, events)
+	require.Len(t, data, 1)
+	require.NotNil(t, data[0])
+	require.Len(t, msgResponses, 1)
+	require.Len(t, msgResponses[0], 1)
+	require.Equal(t, "/"+proto.MessageName(res), msgResponses[0][0].TypeUrl)
+	require.Equal(t, msgResponses[0][0].Value, data[0])
+
+	return proto.Unmarshal(data[0], res)
+}
+
+func TestDispatchLock(t *testing.T) {
+	f := SetupTest(t)
+
+	contract := f.addrs[0]
+	f.delegate(t, contract, 1000)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	// an empty address locks the tokens of the contract
+	err := dispatch(t, f, ctx, contract, bindings.TscMsg{Lock: &bindings.Lock{
+		UnlockDate: "2026-06-01",
+		Amount:     math.NewInt(400),
+	}}, &lockuptypes.MsgLockResponse{})
+	require.NoError(t, err)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, contract, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(400), lock.Amount)
+
+	err = dispatch(t, f, ctx, contract, bindings.TscMsg{Extend: &bindings.Extend{
+		Extensions: []bindings.Extension{{FromDate: "2026-06-01", ToDate: "2026-07-01", Amount: math.NewInt(100)}},
+	}}, &lockuptypes.MsgExtendResponse{})
+	require.NoError(t, err)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, contract, "2026-07-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), lock.Amount)
+
+	// a message without a variant is rejected
+	err = dispatch(t, f, ctx, contract, bindings.TscMsg{}, &lockuptypes.MsgLockResponse{})
+	require.ErrorIs(t, err, wasmtypes.ErrUnknownMsg)
+}
+
+func TestDispatchLockAllowance(t *testing.T) {
+	f := SetupTest(t)
+
+	contract := f.addrs[0]
+	owner := f.addrs[1]
+	f.delegate(t, owner, 1000)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	lockMsg := bindings.TscMsg{Lock: &bindings.Lock{
+		Address:    owner.String(),
+		UnlockDate: "2026-06-01",
+		Amount:     math.NewInt(400),
+	}}
+
+	// the contract cannot lock the tokens of another address without an allowance
+	err := dispatch(t, f, ctx, contract, lockMsg, &lockuptypes.MsgLockResponse{})
+	require.ErrorIs(t, err, lockuptypes.ErrInsufficientLockAllowance)
+
+	require.NoError(t, f.k.SetLockAllowance(ctx, owner, contract, math.NewInt(500), time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+
+	// nor lock after the max unlock date of the allowance
+	late := *lockMsg.Lock
+	late.UnlockDate = "2026-08-01"
+	err = dispatch(t, f, ctx, contract, bindings.TscMsg{Lock: &late}, &lockuptypes.MsgLockResponse{})
+	require.ErrorIs(t, err, lockuptypes.ErrInsufficientLockAllowance)
+
+	err = dispatch(t, f, ctx, contract, lockMsg, &lockuptypes.MsgLockResponse{})
+	require.NoError(t, err)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, owner, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(400), lock.Amount)
+
+	allowance, found, err := f.k.GetLockAllowance(ctx, owner, contract)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), allowance.MaxAmount)
+
+	// an extension must stay within the allowance
+	extendMsg := func(toDate string, amount int64) bindings.TscMsg {
+		return bindings.TscMsg{Extend: &bindings.Extend{
+			Address:    owner.String(),
+			Extensions: []bindings.Extension{{FromDate: "2026-06-01", ToDate: toDate, Amount: math.NewInt(amount)}},
+		}}
+	}
+
+	err = dispatch(t, f, ctx, contract, extendMsg("2026-08-01", 100), &lockuptypes.MsgExtendResponse{})
+	require.ErrorIs(t, err, lockuptypes.ErrInsufficientLockAllowance)
+
+	err = dispatch(t, f, ctx, contract, extendMsg("2026-07-01", 200), &lockuptypes.MsgExtendResponse{})
+	require.ErrorIs(t, err, lockuptypes.ErrInsufficientLockAllowance)
+
+	err = dispatch(t, f, ctx, contract, extendMsg("2026-07-01", 100), &lockuptypes.MsgExtendResponse{})
+	require.NoError(t, err)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, owner, "2026-07-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), lock.Amount)
+
+	// the allowance is used up
+	_, found, err = f.k.GetLockAllowance(ctx, owner, contract)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestDispatchSendDelegateAndLock(t *testing.T) {
+	f := SetupTest(t)
+
+	contract := f.addrs[0]
+	to := f.addrs[1]
+	f.fund(t, contract, 1000)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	err := dispatch(t, f, ctx, contract, bindings.TscMsg{SendDelegateAndLock: &bindings.SendDelegateAndLock{
+		ToAddress:        to.String(),
+		ValidatorAddress: f.valAddr.String(),
+		UnlockDate:       "2026-06-01",
+		Amount:           math.NewInt(300),
+	}}, &lockuptypes.MsgSendDelegateAndLockResponse{})
+	require.NoError(t, err)
+
+	require.Equal(t, math.NewInt(700), f.bankkeeper.GetBalance(ctx, contract, sdk.DefaultBondDenom).Amount)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, to, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(300), lock.Amount)
+}
+
+func TestDispatchOtherMessages(t *testing.T) {
+	f := SetupTest(t)
+
+	msg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: f.addrs[1].String()}}}
+	_, _, _, err := f.messenger.DispatchMsg(f.ctx, f.addrs[0], "", msg)
+	require.NoError(t, err)
+	require.Equal(t, []wasmvmtypes.CosmosMsg{msg}, f.wrapped)
+
+	_, _, _, err = f.messenger.DispatchMsg(f.ctx, f.addrs[0], "", wasmvmtypes.CosmosMsg{Custom: []byte("{")})
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding/bindings"
+	distrokeeper "github.com/TrustedSmartChain/tsc/v2/x/distro/keeper"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// CustomQuerier returns a querier answering TscQuery custom queries.
+func CustomQuerier(lockupQuerier lockuptypes.QueryServer, distroKeeper distrokeeper.Keeper) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var tscQuery bindings.TscQuery
+		if err := json.Unmarshal(request, &tscQuery); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, "tsc query")
+		}
+
+		var (
+			res interface{}
+			err error
+		)
+		switch {
+		case tscQuery.Locks != nil:
+			res, err = locks(ctx, lockupQuerier, tscQuery.Locks.Address)
+		case tscQuery.TotalLocked != nil:
+			res, err = totalLocked(ctx, lockupQuerier)
+		case tscQuery.DistroParams != nil:
+			res, err = distroParams(ctx, distroKeeper)
+		case tscQuery.Spendable != nil:
+			res, err = spendable(ctx, lockupQuerier, tscQuery.Spendable.Address)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown tsc query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, "tsc query response")
+		}
+
+		return bz, nil
+	}
+}
+
+// locks returns all the active locks of address, page by page
+func locks(ctx sdk.Context, lockupQuerier lockuptypes.QueryServer, address string) (*bindings.LocksResponse, error) {
+	res := &bindings.LocksResponse{Locks: []bindings.LockInfo{}}
+
+	var pageReq *query.PageRequest
+	for {
+		page, err := lockupQuerier.Locks(ctx, &lockuptypes.QueryLocksRequest{
+			Address:    address,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lock := range page.Locks {
+			res.Locks = append(res.Locks, bindings.LockInfo{
+				UnlockDate: lock.UnlockDate,
+				Amount:     wasmCoin(lock.Amount),
+			})
+		}
+
+		if len(page.Pagination.NextKey) == 0 {
+			return res, nil
+		}
+		pageReq = &query.PageRequest{Key: page.Pagination.NextKey}
+	}
+}
+
+// totalLocked returns the amount locked across all accounts
+func totalLocked(ctx sdk.Context, lockupQuerier lockuptypes.QueryServer) (*bindings.TotalLockedResponse, error) {
+	res, err := lockupQuerier.TotalLockedAmount(ctx, &lockuptypes.QueryTotalLockedAmountRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.TotalLockedResponse{TotalLocked: wasmCoin(res.TotalLocked)}, nil
+}
+
+// distroParams returns the distro params
+func distroParams(ctx sdk.Context, distroKeeper distrokeeper.Keeper) (*bindings.DistroParamsResponse, error) {
+	params, err := distroKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.DistroParamsResponse{
+		MintingAddress:        params.MintingAddress,
+		ReceivingAddress:      params.ReceivingAddress,
+		Denom:                 params.Denom,
+		MaxSupply:             params.MaxSupply,
+		DistributionStartDate: params.DistributionStartDate,
+		MonthsInHalvingPeriod: params.MonthsInHalvingPeriod,
+	}, nil
+}
+
+// spendable returns the bond denom balance address can send given its locks
+func spendable(ctx sdk.Context, lockupQuerier lockuptypes.QueryServer, address string) (*bindings.SpendableResponse, error) {
+	res, err := lockupQuerier.SpendableBondBalance(ctx, &lockuptypes.QuerySpendableBondBalanceRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindings.SpendableResponse{Spendable: wasmCoin(res.Spendable)}, nil
+}
+
+// wasmCoin converts a coin to its CosmWasm representation
+func wasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
+	return wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+}
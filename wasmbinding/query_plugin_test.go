@@ -0,0 +1,94 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/wasmbinding/bindings"
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// customQuery sends request as a custom query and decodes its response into res
+func customQuery(t *testing.T, f *testFixture, ctx sdk.Context, request bindings.TscQuery, res interface{}) error {
+	t.Helper()
+
+	bz, err := f.querier(ctx, custom(t, request))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(bz, res)
+}
+
+func TestCustomQuerier(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	f.fund(t, addr, 1000)
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &lockuptypes.GenesisState{
+		Params: lockuptypes.DefaultParams(),
+		AccountLocks: []lockuptypes.AccountLocks{
+			{Address: addr.String(), Locks: []*lockuptypes.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(100)},
+				{UnlockDate: "2026-07-01", Amount: math.NewInt(200)},
+			}},
+		},
+		ExpirationQueue: []lockuptypes.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: addr.String(), Amount: math.NewInt(100)},
+			{UnlockDate: "2026-07-01", Address: addr.String(), Amount: math.NewInt(200)},
+		},
+	}))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	coin := func(amount string) wasmvmtypes.Coin {
+		return wasmvmtypes.Coin{Denom: sdk.DefaultBondDenom, Amount: amount}
+	}
+
+	var locks bindings.LocksResponse
+	require.NoError(t, customQuery(t, f, ctx, bindings.TscQuery{Locks: &bindings.Locks{Address: addr.String()}}, &locks))
+	require.Equal(t, []bindings.LockInfo{
+		{UnlockDate: "2026-06-01", Amount: coin("100")},
+		{UnlockDate: "2026-07-01", Amount: coin("200")},
+	}, locks.Locks)
+
+	// an address without locks has an empty list
+	locks = bindings.LocksResponse{}
+	require.NoError(t, customQuery(t, f, ctx, bindings.TscQuery{Locks: &bindings.Locks{Address: f.addrs[1].String()}}, &locks))
+	require.NotNil(t, locks.Locks)
+	require.Empty(t, locks.Locks)
+
+	var total bindings.TotalLockedResponse
+	require.NoError(t, customQuery(t, f, ctx, bindings.TscQuery{TotalLocked: &bindings.TotalLocked{}}, &total))
+	require.Equal(t, coin("300"), total.TotalLocked)
+
+	var spendable bindings.SpendableResponse
+	require.NoError(t, customQuery(t, f, ctx, bindings.TscQuery{Spendable: &bindings.Spendable{Address: addr.String()}}, &spendable))
+	require.Equal(t, coin("700"), spendable.Spendable)
+
+	var distroParams bindings.DistroParamsResponse
+	require.NoError(t, customQuery(t, f, ctx, bindings.TscQuery{DistroParams: &bindings.DistroParams{}}, &distroParams))
+
+	params := distrotypes.DefaultParams()
+	require.Equal(t, bindings.DistroParamsResponse{
+		MintingAddress:        params.MintingAddress,
+		ReceivingAddress:      params.ReceivingAddress,
+		Denom:                 params.Denom,
+		MaxSupply:             params.MaxSupply,
+		DistributionStartDate: params.DistributionStartDate,
+		MonthsInHalvingPeriod: params.MonthsInHalvingPeriod,
+	}, distroParams)
+
+	// a query without a variant is unsupported
+	err := customQuery(t, f, ctx, bindings.TscQuery{}, &struct{}{})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+}
@@ -0,0 +1,172 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/integration"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	distrokeeper "github.com/TrustedSmartChain/tsc/v2/x/distro/keeper"
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+	lockupkeeper "github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+var maccPerms = map[string][]string{
+	authtypes.FeeCollectorName:     nil,
+	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	minttypes.ModuleName:           {authtypes.Minter},
+	govtypes.ModuleName:            {authtypes.Burner},
+	distrtypes.ModuleName:          nil,
+	lockuptypes.ModuleName:         {authtypes.Burner},
+}
+
+type testFixture struct {
+	ctx          sdk.Context
+	k            lockupkeeper.Keeper
+	distroKeeper distrokeeper.Keeper
+
+	bankkeeper    bankkeeper.BaseKeeper
+	stakingKeeper *stakingkeeper.Keeper
+
+	messenger wasmkeeper.Messenger
+	querier   wasmkeeper.CustomQuerier
+
+	// wrapped records the messages the messenger passes on
+	wrapped []wasmvmtypes.CosmosMsg
+
+	addrs   []sdk.AccAddress
+	valAddr sdk.ValAddress
+}
+
+func SetupTest(t *testing.T) *testFixture {
+	t.Helper()
+	f := new(testFixture)
+
+	// The app package imports this one, so the addresses use the SDK prefixes.
+	cfg := sdk.GetConfig()
+
+	logger := log.NewTestLogger(t)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	stakingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	lockuptypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	govModAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	f.addrs = simtestutil.CreateIncrementalAccounts(4)
+	f.valAddr = sdk.ValAddress(f.addrs[3])
+
+	keys := storetypes.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, distrtypes.StoreKey, distrotypes.StoreKey, lockuptypes.StoreKey)
+	f.ctx = sdk.NewContext(integration.CreateMultiStore(keys, logger), cmtproto.Header{}, false, logger)
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[authtypes.StoreKey]),
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		sdkaddress.NewBech32Codec(cfg.GetBech32AccountAddrPrefix()), cfg.GetBech32AccountAddrPrefix(),
+		govModAddr,
+	)
+
+	f.bankkeeper = bankkeeper.NewBaseKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[banktypes.StoreKey]),
+		accountKeeper,
+		nil,
+		govModAddr, logger,
+	)
+
+	f.stakingKeeper = stakingkeeper.NewKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
+		accountKeeper, f.bankkeeper, govModAddr,
+		sdkaddress.NewBech32Codec(cfg.GetBech32ValidatorAddrPrefix()),
+		sdkaddress.NewBech32Codec(cfg.GetBech32ConsensusAddrPrefix()),
+	)
+	require.NoError(t, f.stakingKeeper.SetParams(f.ctx, stakingtypes.DefaultParams()))
+
+	distrKeeper := distrkeeper.NewKeeper(
+		encCfg.Codec, runtime.NewKVStoreService(keys[distrtypes.StoreKey]),
+		accountKeeper, f.bankkeeper, f.stakingKeeper,
+		authtypes.FeeCollectorName, govModAddr,
+	)
+	require.NoError(t, distrKeeper.FeePool.Set(f.ctx, distrtypes.InitialFeePool()))
+
+	f.distroKeeper = distrokeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[distrotypes.StoreKey]), logger, govModAddr, accountKeeper, f.bankkeeper)
+	require.NoError(t, f.distroKeeper.Params.Set(f.ctx, distrotypes.DefaultParams()))
+
+	f.k = lockupkeeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(keys[lockuptypes.StoreKey]), logger, govModAddr, accountKeeper, f.bankkeeper, f.stakingKeeper, distrKeeper)
+	f.stakingKeeper.SetHooks(f.k.Hooks())
+	require.NoError(t, f.k.InitGenesis(f.ctx, lockuptypes.DefaultGenesis()))
+
+	require.NoError(t, f.stakingKeeper.SetValidator(f.ctx, stakingtypes.Validator{
+		OperatorAddress: f.valAddr.String(),
+		Status:          stakingtypes.Unbonded,
+		Tokens:          math.ZeroInt(),
+		DelegatorShares: math.LegacyZeroDec(),
+	}))
+
+	wrapped := wasmkeeper.MessageHandlerFunc(func(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+		f.wrapped = append(f.wrapped, msg)
+		return nil, nil, nil, nil
+	})
+	f.messenger = CustomMessageDecorator(f.k, f.stakingKeeper)(wrapped)
+	f.querier = CustomQuerier(lockupkeeper.NewQuerier(f.k), f.distroKeeper)
+
+	return f
+}
+
+// fund mints amount bond tokens to addr
+func (f *testFixture) fund(t *testing.T, addr sdk.AccAddress, amount int64) {
+	t.Helper()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, coins))
+}
+
+// delegate funds addr with amount bond tokens and delegates them
+func (f *testFixture) delegate(t *testing.T, addr sdk.AccAddress, amount int64) {
+	t.Helper()
+
+	f.fund(t, addr, amount)
+
+	validator, err := f.stakingKeeper.GetValidator(f.ctx, f.valAddr)
+	require.NoError(t, err)
+	_, err = f.stakingKeeper.Delegate(f.ctx, addr, math.NewInt(amount), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+}
+
+// custom returns v encoded as the payload of a custom message or query
+func custom(t *testing.T, v interface{}) json.RawMessage {
+	t.Helper()
+
+	bz, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return bz
+}
@@ -0,0 +1,65 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	distrokeeper "github.com/TrustedSmartChain/tsc/v2/x/distro/keeper"
+	distrotypes "github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+	lockupkeeper "github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	lockuptypes "github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// CustomCapability is the capability a contract requires to send TscMsg
+// custom messages and TscQuery custom queries.
+const CustomCapability = "tsc"
+
+// RegisterCustomPlugins returns the wasm keeper options adding the TscMsg and
+// TscQuery bindings, and the lockup and distro queries contracts may send as
+// Stargate or gRPC queries.
+func RegisterCustomPlugins(
+	cdc codec.Codec,
+	queryRouter wasmkeeper.GRPCQueryRouter,
+	lockupKeeper lockupkeeper.Keeper,
+	stakingKeeper lockuptypes.StakingKeeper,
+	distroKeeper distrokeeper.Keeper,
+) []wasmkeeper.Option {
+	acceptList := AcceptedQueries()
+
+	return []wasmkeeper.Option{
+		wasmkeeper.WithMessageHandlerDecorator(CustomMessageDecorator(lockupKeeper, stakingKeeper)),
+		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+			Custom:   CustomQuerier(lockupkeeper.NewQuerier(lockupKeeper), distroKeeper),
+			Stargate: wasmkeeper.AcceptListStargateQuerier(acceptList, queryRouter, cdc),
+			Grpc:     wasmkeeper.AcceptListGrpcQuerier(acceptList, queryRouter, cdc),
+		}),
+	}
+}
+
+// AcceptedQueries returns the lockup and distro queries contracts may send
+// as Stargate or gRPC queries, with their response types.
+func AcceptedQueries() wasmkeeper.AcceptedQueries {
+	return wasmkeeper.AcceptedQueries{
+		// lockup
+		"/lockup.v1.Query/Params":                  func() proto.Message { return &lockuptypes.QueryParamsResponse{} },
+		"/lockup.v1.Query/ActiveLocks":             func() proto.Message { return &lockuptypes.QueryActiveLocksResponse{} },
+		"/lockup.v1.Query/TotalLockedAmount":       func() proto.Message { return &lockuptypes.QueryTotalLockedAmountResponse{} },
+		"/lockup.v1.Query/UnlockSchedule":          func() proto.Message { return &lockuptypes.QueryUnlockScheduleResponse{} },
+		"/lockup.v1.Query/UnlockScheduleByAddress": func() proto.Message { return &lockuptypes.QueryUnlockScheduleByAddressResponse{} },
+		"/lockup.v1.Query/AccountLocks":            func() proto.Message { return &lockuptypes.QueryAccountLocksResponse{} },
+		"/lockup.v1.Query/AccountLocksBatch":       func() proto.Message { return &lockuptypes.QueryAccountLocksBatchResponse{} },
+		"/lockup.v1.Query/Locks":                   func() proto.Message { return &lockuptypes.QueryLocksResponse{} },
+		"/lockup.v1.Query/SlashAdjustments":        func() proto.Message { return &lockuptypes.QuerySlashAdjustmentsResponse{} },
+		"/lockup.v1.Query/LockStatus":              func() proto.Message { return &lockuptypes.QueryLockStatusResponse{} },
+		"/lockup.v1.Query/LocksByValidator":        func() proto.Message { return &lockuptypes.QueryLocksByValidatorResponse{} },
+		"/lockup.v1.Query/LockHistory":             func() proto.Message { return &lockuptypes.QueryLockHistoryResponse{} },
+		"/lockup.v1.Query/SpendableBondBalance":    func() proto.Message { return &lockuptypes.QuerySpendableBondBalanceResponse{} },
+		"/lockup.v1.Query/MaxUndelegatable":        func() proto.Message { return &lockuptypes.QueryMaxUndelegatableResponse{} },
+		"/lockup.v1.Query/LockAllowance":           func() proto.Message { return &lockuptypes.QueryLockAllowanceResponse{} },
+
+		// distro
+		"/distro.v1.Query/Params": func() proto.Message { return &distrotypes.QueryParamsResponse{} },
+	}
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+)
+
+// TotalDistributableAt returns the supply the halving schedule allows by the
+// day of date. It is zero before the distribution start date.
+func (k Keeper) TotalDistributableAt(ctx context.Context, date time.Time) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return totalDistributableAt(params, date)
+}
+
+// MintableNow returns the amount the minting address can mint at the current
+// block time without going over the distributable limit or the max supply.
+func (k Keeper) MintableNow(ctx sdk.Context) (math.Int, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	limit, err := totalDistributableAt(params, ctx.BlockTime())
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	maxSupply, ok := math.NewIntFromString(params.MaxSupply)
+	if ok && maxSupply.LT(limit) {
+		limit = maxSupply
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.Denom).Amount
+	if !supply.LT(limit) {
+		return math.ZeroInt(), nil
+	}
+
+	return limit.Sub(supply), nil
+}
+
+// totalDistributableAt normalizes date to its day, like validateMintingLimits
+// does with the block time, and returns the limit of the halving schedule
+func totalDistributableAt(params types.Params, date time.Time) (math.Int, error) {
+	schedule, err := newHalvingSchedule(params)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	targetDate := date.UTC().Truncate(24 * time.Hour)
+	if targetDate.Before(schedule.StartDate) {
+		return math.ZeroInt(), nil
+	}
+
+	return schedule.TotalDistributableAt(targetDate)
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/distro/types"
+)
+
+func TestMintableNow(t *testing.T) {
+	f := SetupTest(t)
+
+	params := types.DefaultParams()
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	// nothing is distributable before the start date
+	total, err := f.k.TotalDistributableAt(f.ctx, time.Date(2025, 7, 21, 23, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+
+	// the limit is per day
+	total, err = f.k.TotalDistributableAt(f.ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.True(t, total.IsPositive())
+
+	sameDay, err := f.k.TotalDistributableAt(f.ctx, time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, total, sameDay)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	mintable, err := f.k.MintableNow(ctx)
+	require.NoError(t, err)
+	require.Equal(t, total, mintable)
+
+	// the current supply counts against the limit
+	minted := sdk.NewCoins(sdk.NewCoin(params.Denom, math.NewInt(1_000)))
+	f.bankkeeper.InitGenesis(ctx, &banktypes.GenesisState{
+		Params:   banktypes.DefaultParams(),
+		Balances: []banktypes.Balance{{Address: f.addrs[0].String(), Coins: minted}},
+		Supply:   minted,
+	})
+
+	mintable, err = f.k.MintableNow(ctx)
+	require.NoError(t, err)
+	require.Equal(t, total.SubRaw(1_000), mintable)
+
+	mintable, err = f.k.MintableNow(ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.True(t, mintable.IsZero())
+}
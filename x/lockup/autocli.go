@@ -9,22 +9,97 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service:           modulev1.Query_ServiceDesc.ServiceName,
+			Service: modulev1.Query_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
-				// {
-				// 	RpcMethod: "Params",
-				// 	Use:       "params",
-				// 	Short:     "Query the current consensus parameters",
-				// },
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current lockup parameters",
+				},
+				{
+					RpcMethod:      "AccountLocksBatch",
+					Use:            "account-locks-batch [address]...",
+					Short:          "Query the active locks of a list of addresses",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
+				{
+					RpcMethod: "UnlockSchedule",
+					Use:       "unlock-schedule",
+					Short:     "Query the amount unlocking across all accounts per day, week or month",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"from_date": {Usage: "first unlock date included, defaults to now"},
+						"to_date":   {Usage: "unlock date the calendar stops before, defaults to no limit"},
+						"bucket":    {Usage: "day, week or month"},
+					},
+				},
+				{
+					RpcMethod:      "UnlockScheduleByAddress",
+					Use:            "unlock-schedule-by-address [address]",
+					Short:          "Query the amount of an address unlocking per day, week or month",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"from_date": {Usage: "first unlock date included, defaults to now"},
+						"to_date":   {Usage: "unlock date the calendar stops before, defaults to no limit"},
+						"bucket":    {Usage: "day, week or month"},
+					},
+				},
+				{
+					RpcMethod:      "SlashAdjustments",
+					Use:            "slash-adjustments [address]",
+					Short:          "Query the lock amount removed from an address because of validator slashes",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "LockStatus",
+					Use:            "lock-status [address]",
+					Short:          "Query the amount an address has locked now and its next unlock",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "LocksByValidator",
+					Use:            "locks-by-validator [validator-address]",
+					Short:          "Query the active locks bound to a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "LockHistory",
+					Use:            "lock-history [address]",
+					Short:          "Query the recorded lock changes of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SpendableBondBalance",
+					Use:            "spendable-bond-balance [address]",
+					Short:          "Query the bond denom balance an address can send given its locks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "MaxUndelegatable",
+					Use:            "max-undelegatable [address] [validator-address]",
+					Short:          "Query the amount an address can undelegate from a validator given its locks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "validator_address"}},
+				},
+				{
+					RpcMethod:      "LockAllowance",
+					Use:            "lock-allowance [owner] [spender]",
+					Short:          "Query the allowance of spender to lock on behalf of owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}, {ProtoField: "spender"}},
+				},
+				{
+					RpcMethod:      "LockTransferAcceptance",
+					Use:            "lock-transfer-acceptance [address] [from-address]",
+					Short:          "Query whether an address accepts the lock transfers of another address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "from_address"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
-			Service:           modulev1.Msg_ServiceDesc.ServiceName,
+			Service: modulev1.Msg_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
-				// {
-				// 	RpcMethod: "UpdateParams",
-				// 	Skip:      false, // set to true if authority gated
-				// },
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // authority gated
+				},
 			},
 		},
 	}
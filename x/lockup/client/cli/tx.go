@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -14,6 +15,9 @@ import (
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 )
 
+// FlagRefuse makes accept-lock-transfers refuse the lock transfers again.
+const FlagRefuse = "refuse"
+
 // !NOTE: Must enable in module.go (disabled in favor of autocli.go)
 
 // NewTxCmd returns a root CLI command handler for certain modules
@@ -32,15 +36,22 @@ func NewTxCmd() *cobra.Command {
 		CmdExtend(),
 		CmdSendDelegateAndLock(),
 		CmdMultiSendDelegateAndLock(),
+		CmdUnlockEarly(),
+		CmdTransferLock(),
+		CmdAcceptLockTransfers(),
+		CmdLockWithSchedule(),
 	)
 	return txCmd
 }
 
 func CmdLock() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "lock unlock-date amount",
+		Use:   "lock unlock-date amount [validator-address]",
 		Short: "Lock tokens until a specific date",
-		Args:  cobra.ExactArgs(2),
+		Long: `Lock tokens until unlock-date. The unlock date is a date (2026-12-01), which unlocks at
+midnight UTC, an RFC3339 timestamp (2026-12-01T15:00:00Z) or Unix seconds. When a
+validator address is given, the lock is bound to the tokens delegated to that validator.`,
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -58,6 +69,189 @@ func CmdLock() *cobra.Command {
 				UnlockDate: unlockDate,
 				Amount:     sdk.NewCoin("aTSC", amount),
 			}
+			if len(args) > 2 {
+				msg.ValidatorAddress = args[2]
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnlockEarly() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-early unlock-date amount",
+		Short: "Release locked tokens before their unlock date against a penalty",
+		Long: `Release some or all of the lock that unlocks on unlock-date before that date.
+The penalty set by governance is paid from your spendable balance.
+Example: 'unlock-early 2026-12-01 1000000000'`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unlockDate := args[0]
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			msg := &types.MsgUnlockEarly{
+				Address:    clientCtx.GetFromAddress().String(),
+				UnlockDate: unlockDate,
+				Amount:     sdk.NewCoin("aTSC", amount),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [to-address] [validator-address] [unlock-date] [amount]",
+		Short: "Transfer a lock and its backing delegation to another address",
+		Long: `Move some or all of the lock that unlocks on unlock-date to another address, together with
+the same amount of your delegation to the given validator. The recipient must first accept
+your lock transfers with accept-lock-transfers.
+Example:
+  transfer-lock optio1abc... optiovaloper1xyz... 2026-12-01 1000`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddress := args[0]
+			validatorAddress := args[1]
+			unlockDate := args[2]
+			amount, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+
+			msg := &types.MsgTransferLock{
+				FromAddress:      clientCtx.GetFromAddress().String(),
+				ToAddress:        toAddress,
+				UnlockDate:       unlockDate,
+				Amount:           sdk.NewCoin("aTSC", amount),
+				ValidatorAddress: validatorAddress,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAcceptLockTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-lock-transfers [from-address]",
+		Short: "Accept the locks another address transfers to you",
+		Long: `Accept the locks, and the delegations backing them, that from-address transfers to you
+with transfer-lock. With --refuse, refuse its lock transfers again.
+Example:
+  accept-lock-transfers optio1abc...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			refuse, err := cmd.Flags().GetBool(FlagRefuse)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptLockTransfers(clientCtx.GetFromAddress().String(), args[0], !refuse)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRefuse, false, "Refuse the lock transfers of from-address again")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdLockWithSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-with-schedule [to-address] [validator-address] [cliff-date] [linear|monthly] [periods] [amount]",
+		Short: "Send tokens to an address, delegate them, and lock them under a release schedule",
+		Long: `Send tokens to another address, delegate them to a validator, and lock them under a graduated release schedule.
+The amount is released in periods equal steps: the first on cliff-date, then one step per day (linear) or per calendar month (monthly).
+Example:
+  lock-with-schedule optio1abc... optiovaloper1xyz... 2026-12-01 monthly 12 12000`,
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddress := args[0]
+			validatorAddress := args[1]
+			cliffDate := args[2]
+
+			var releaseType types.ReleaseType
+			switch strings.ToLower(args[3]) {
+			case "linear":
+				releaseType = types.RELEASE_TYPE_LINEAR
+			case "monthly":
+				releaseType = types.RELEASE_TYPE_MONTHLY
+			default:
+				return fmt.Errorf("invalid release type: %s, expected linear or monthly", args[3])
+			}
+
+			periods, err := strconv.ParseUint(args[4], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid periods: %s", args[4])
+			}
+
+			amount, ok := math.NewIntFromString(args[5])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[5])
+			}
+
+			msg := &types.MsgLockWithSchedule{
+				FromAddress:      clientCtx.GetFromAddress().String(),
+				ToAddress:        toAddress,
+				ValidatorAddress: validatorAddress,
+				Amount:           sdk.NewCoin("aTSC", amount),
+				Schedule: types.ReleaseSchedule{
+					CliffDate:   cliffDate,
+					ReleaseType: releaseType,
+					Periods:     uint32(periods),
+				},
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -76,7 +270,8 @@ func CmdExtend() *cobra.Command {
 		Use:   "extend [from-date:to-date:amount] [from-date:to-date:amount]...",
 		Short: "Extend lock unlock dates",
 		Long: `Extend the unlock date of existing locks. You can specify multiple extensions.
-		Example: '2026-12-01:2027-12-01:1000000000' extends a lock that unlocks on 2026-12-01 by locking an additional 1000000000 until 2027-12-01.`,
+		Example: '2026-12-01:2027-12-01:1000000000' extends a lock that unlocks on 2026-12-01 by locking an additional 1000000000 until 2027-12-01.
+		Dates may also be RFC3339 timestamps or Unix seconds, e.g. '2026-12-01:2027-12-01T15:00:00Z:1000000000'.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -86,16 +281,19 @@ func CmdExtend() *cobra.Command {
 
 			extensions := make([]*types.Extension, 0, len(args))
 			for i, arg := range args {
-				parts := strings.Split(arg, ":")
-				if len(parts) != 3 {
+				dates, amountStr, found := cutLast(arg, ":")
+				if !found {
 					return fmt.Errorf("invalid extension format at position %d: expected 'from-date:to-date:amount', got '%s'", i, arg)
 				}
 
-				fromDate := parts[0]
-				toDate := parts[1]
-				amount, ok := math.NewIntFromString(parts[2])
+				fromDate, toDate, found := splitUnlockTimes(dates)
+				if !found {
+					return fmt.Errorf("invalid extension format at position %d: expected 'from-date:to-date:amount', got '%s'", i, arg)
+				}
+
+				amount, ok := math.NewIntFromString(amountStr)
 				if !ok {
-					return fmt.Errorf("invalid amount at position %d: %s", i, parts[2])
+					return fmt.Errorf("invalid amount at position %d: %s", i, amountStr)
 				}
 
 				extensions = append(extensions, &types.Extension{
@@ -170,7 +368,7 @@ func CmdMultiSendDelegateAndLock() *cobra.Command {
 		Short: "Send tokens to multiple addresses, delegate them, and lock them",
 		Long: `Send tokens to multiple addresses, delegate them to validators, and lock them until specific unlock dates.
 Example: 
-  multi-send-delegate-and-lock optio1abc...:optiovaloper1xyz...:2026-12-01:1000 optio1def...:optiovaloper1uvw...:2027-01-01:2000`,
+  multi-send-delegate-and-lock optio1abc...:optiovaloper1xyz...:2026-12-01:1000 optio1def...:optiovaloper1uvw...:2027-01-01T15:00:00Z:2000`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -182,17 +380,23 @@ Example:
 			totalAmount := math.ZeroInt()
 
 			for i, arg := range args {
-				parts := strings.Split(arg, ":")
-				if len(parts) != 4 {
+				// The unlock date may contain colons itself (RFC3339), so the
+				// addresses are taken from the front and the amount from the back.
+				parts := strings.SplitN(arg, ":", 3)
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid output format at position %d: expected 'to-address:validator-address:unlock-date:amount', got '%s'", i, arg)
+				}
+
+				unlockDate, amountStr, found := cutLast(parts[2], ":")
+				if !found {
 					return fmt.Errorf("invalid output format at position %d: expected 'to-address:validator-address:unlock-date:amount', got '%s'", i, arg)
 				}
 
 				toAddress := parts[0]
 				validatorAddress := parts[1]
-				unlockDate := parts[2]
-				amount, ok := math.NewIntFromString(parts[3])
+				amount, ok := math.NewIntFromString(amountStr)
 				if !ok {
-					return fmt.Errorf("invalid amount at position %d: %s", i, parts[3])
+					return fmt.Errorf("invalid amount at position %d: %s", i, amountStr)
 				}
 
 				totalAmount = totalAmount.Add(amount)
@@ -222,3 +426,29 @@ Example:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// splitUnlockTimes splits "from:to" into two unlock times. RFC3339 timestamps
+// contain colons, so every colon is tried until both halves parse.
+func splitUnlockTimes(s string) (from, to string, found bool) {
+	for i := 0; i < len(s); i++ {
+		if s[i] != ':' {
+			continue
+		}
+		if _, err := types.ParseUnlockTime(s[:i]); err != nil {
+			continue
+		}
+		if _, err := types.ParseUnlockTime(s[i+1:]); err != nil {
+			continue
+		}
+		return s[:i], s[i+1:], true
+	}
+	return "", "", false
+}
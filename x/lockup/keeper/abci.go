@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// EndBlocker drains the expiration queue up to the current block time. Every
+// expired entry is removed from both the locks_by_date queue and the
+// per-address lock records, and a lock_expired event is emitted for it. Lock
+// schedules whose last step is released are removed as well, and the lock
+// history is pruned to its retention window.
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blockTime := ctx.BlockTime()
+
+	events := sdk.Events{}
+	err := k.IterateAndDeleteExpiredLocks(ctx, blockTime, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		unlockDate := types.FormatUnlockTime(unlockTime)
+
+		if err := k.removeExpiredLockByAddress(ctx, addr, unlockDate, amount); err != nil {
+			return err
+		}
+
+		if err := k.LockupHooks().AfterLockExpired(ctx, addr, unlockTime, amount); err != nil {
+			return err
+		}
+
+		if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+			Change:     types.LOCK_HISTORY_TYPE_EXPIRED,
+			UnlockDate: unlockDate,
+			Amount:     amount,
+		}); err != nil {
+			return err
+		}
+
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeLockExpired,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, unlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		))
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	if err := k.processScheduleReleases(ctx); err != nil {
+		return err
+	}
+
+	return k.pruneLockHistory(ctx)
+}
+
+// removeExpiredLockByAddress removes the expired amount from the address's
+// lock on unlockDate, deleting the lock once nothing is left.
+func (k Keeper) removeExpiredLockByAddress(ctx sdk.Context, addr sdk.AccAddress, unlockDate string, amount math.Int) error {
+	lock, found := k.GetLockByAddressAndDate(ctx, addr, unlockDate)
+	if !found {
+		k.Logger().Error("expired lock missing from address store", "address", addr.String(), "unlock_date", unlockDate)
+		return nil
+	}
+
+	if lock.Amount.LTE(amount) {
+		return k.RemoveLockByAddressAndDate(ctx, addr, unlockDate)
+	}
+
+	return k.SetLockByAddress(ctx, addr, &types.Lock{
+		UnlockDate: lock.UnlockDate,
+		Amount:     lock.Amount.Sub(amount),
+	})
+}
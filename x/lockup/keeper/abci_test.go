@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndBlockerPrunesExpiredLocks(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-02", Amount: math.NewInt(10)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+			{UnlockDate: "2026-01-02", Address: addr2, Amount: math.NewInt(10)},
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(50)},
+		},
+	}))
+
+	// a lock unlocking on the block day is no longer locked and is pruned
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	locks, err := f.k.GetLocksByAddress(ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	require.Equal(t, "2026-06-01", locks[0].UnlockDate)
+
+	locks, err = f.k.GetLocksByAddress(ctx, f.addrs[1])
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	got := f.k.ExportGenesis(ctx)
+	require.NoError(t, got.Validate())
+	require.Equal(t, []types.ExpirationQueueEntry{
+		{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(50)},
+	}, got.ExpirationQueue)
+
+	var expired int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockExpired {
+			expired++
+		}
+	}
+	require.Equal(t, 2, expired)
+
+	// running again in the same day is a no-op
+	require.NoError(t, f.k.EndBlocker(ctx))
+	require.Len(t, f.k.ExportGenesis(ctx).ExpirationQueue, 1)
+}
+
+func TestSubDayUnlockTimes(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+	setDelegations(t, f, valAddr, map[string]int64{addr.String(): 1000})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 2, 6, 0, 0, 0, time.UTC))
+
+	morning := strconv.FormatInt(time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC).Unix(), 10)
+	for _, unlockDate := range []string{morning, "2026-01-02T17:00:00+02:00", "2026-01-03"} {
+		_, err := f.msgServer.Lock(ctx, types.NewMsgLock(addr.String(), unlockDate, sdk.NewInt64Coin(bondDenom, 100)))
+		require.NoError(t, err)
+	}
+
+	// an unlock time earlier on the block day is already in the past
+	_, err := f.msgServer.Lock(ctx, types.NewMsgLock(addr.String(), "2026-01-02T05:59:59Z", sdk.NewInt64Coin(bondDenom, 100)))
+	require.Error(t, err)
+
+	res, err := f.queryServer.Locks(ctx, &types.QueryLocksRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 3)
+	require.Equal(t, "2026-01-02T08:00:00Z", res.Locks[0].UnlockDate)
+	require.Equal(t, "2026-01-02T15:00:00Z", res.Locks[1].UnlockDate)
+	require.Equal(t, "2026-01-03", res.Locks[2].UnlockDate)
+
+	// locks are released at their exact unlock time
+	ctx = ctx.WithBlockTime(time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	locks, err := f.k.GetLocksByAddress(ctx, addr)
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	require.Equal(t, "2026-01-03", locks[0].UnlockDate)
+
+	locked, err := f.k.GetLockedAmountByAddress(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), *locked)
+
+	total, err := f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), total)
+}
+
+func TestEndBlockerProcessesScheduleReleases(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0]
+	addr2 := f.addrs[1]
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		LockSchedules: []types.LockSchedule{
+			{Id: 0, Address: addr1.String(), Amount: math.NewInt(400), Slashed: math.ZeroInt(), Schedule: types.ReleaseSchedule{
+				CliffDate: "2026-02-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 4,
+			}},
+			{Id: 1, Address: addr2.String(), Amount: math.NewInt(100), Slashed: math.ZeroInt(), Schedule: types.ReleaseSchedule{
+				CliffDate: "2026-06-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 1,
+			}},
+		},
+		NextScheduleId: 2,
+	}))
+
+	total, err := f.k.GetTotalScheduledLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), total)
+
+	// the total accounts for releases not processed yet
+	ctx = ctx.WithBlockTime(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	total, err = f.k.GetTotalScheduledLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), total)
+
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	stored, err := f.k.ScheduledLocked.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), stored)
+
+	has, err := f.k.LockSchedulesByRelease.Has(ctx, collections.Join3(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), addr1, uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	total, err = f.k.GetTotalScheduledLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), total)
+
+	// the migration rebuilds the same index and total
+	require.NoError(t, f.k.ScheduledLocked.Set(ctx, math.ZeroInt()))
+	require.NoError(t, f.k.Params.Set(ctx, types.Params{MaxLockMonths: 24, MinLockAmount: math.ZeroInt(), EarlyUnlockPenaltyRate: math.LegacyZeroDec()}))
+	require.NoError(t, keeper.NewMigrator(f.k).Migrate2to3(ctx))
+
+	params, err := f.k.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, params.LockScheduleEnabled)
+	require.Equal(t, types.DefaultMaxLockSchedules, params.MaxLockSchedules)
+	require.Equal(t, types.DefaultPrecompileGas, params.PrecompileGas)
+
+	stored, err = f.k.ScheduledLocked.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), stored)
+
+	// both schedules are removed once released
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	got := f.k.ExportGenesis(ctx)
+	require.Empty(t, got.LockSchedules)
+
+	stored, err = f.k.ScheduledLocked.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, stored.IsZero())
+
+	iter, err := f.k.LockSchedulesByRelease.Iterate(ctx, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 	"github.com/stretchr/testify/require"
 )
@@ -10,11 +11,70 @@ import (
 func TestGenesis(t *testing.T) {
 	f := SetupTest(t)
 
-	genesisState := &types.GenesisState{}
+	genesisState := types.DefaultGenesis()
 
-	f.k.InitGenesis(f.ctx, genesisState)
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
 
 	got := f.k.ExportGenesis(f.ctx)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.Params, got.Params)
+	require.Empty(t, got.AccountLocks)
+	require.Empty(t, got.ExpirationQueue)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(10)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+			{UnlockDate: "2026-01-01", Address: addr2, Amount: math.NewInt(10)},
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(50)},
+		},
+	}
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, genesisState))
+
+	locks, err := f.k.GetLocksByAddress(f.ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Len(t, locks, 2)
+	require.Equal(t, "2026-01-01", locks[0].UnlockDate, "locks must be stored ordered by unlock date")
+
+	got := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, got.Validate())
+	require.Len(t, got.AccountLocks, 2)
+	require.ElementsMatch(t, genesisState.ExpirationQueue, got.ExpirationQueue)
+
+	// a fresh chain initialised from the export must export the same state
+	f2 := SetupTest(t)
+	require.NoError(t, f2.k.InitGenesis(f2.ctx, got))
+	require.Equal(t, got, f2.k.ExportGenesis(f2.ctx))
+}
+
+func TestInitGenesisRejectsInconsistentState(t *testing.T) {
+	f := SetupTest(t)
+
+	genesisState := &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: f.addrs[0].String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+			}},
+		},
+	}
 
+	require.Error(t, f.k.InitGenesis(f.ctx, genesisState))
 }
@@ -54,3 +54,64 @@ func (k Keeper) GetDelegationAmount(ctx sdk.Context, delAddr sdk.AccAddress, val
 	tokens := validator.TokensFromShares(delegation.GetShares())
 	return tokens.Ceil().TruncateInt(), nil
 }
+
+// GetUncoveredLockedAmount returns the locked and delegated amounts of addr
+// and the part of its locks not covered by delegations, max(0, locked −
+// delegated), which has to stay in its bank balance. The delegations of
+// addresses without locks are not looked up and reported as zero.
+func (k Keeper) GetUncoveredLockedAmount(ctx sdk.Context, addr sdk.AccAddress) (locked, delegated, uncovered math.Int, err error) {
+	totalLocked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return math.Int{}, math.Int{}, math.Int{}, err
+	}
+	if totalLocked.IsZero() {
+		return math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), nil
+	}
+
+	totalDelegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return math.Int{}, math.Int{}, math.Int{}, err
+	}
+
+	return *totalLocked, *totalDelegated, math.MaxInt(totalLocked.Sub(*totalDelegated), math.ZeroInt()), nil
+}
+
+// GetSpendableBondBalance returns the bond denom balance addr can send, i.e.
+// its bank balance minus the part of its locks not covered by delegations.
+// It is the amount SendRestrictionFn lets through.
+func (k Keeper) GetSpendableBondBalance(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	_, _, uncovered, err := k.GetUncoveredLockedAmount(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, bondDenom).Amount
+
+	return math.MaxInt(balance.Sub(uncovered), math.ZeroInt()), nil
+}
+
+// GetMaxUndelegatable returns the amount addr can undelegate from valAddr in
+// a transaction without its delegations dropping below its locks, the check
+// made by the staking hooks.
+func (k Keeper) GetMaxUndelegatable(ctx sdk.Context, addr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	delegation, err := k.GetDelegationAmount(ctx, addr, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	locked, delegated, _, err := k.GetUncoveredLockedAmount(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if locked.IsZero() {
+		return delegation, nil
+	}
+
+	return math.MaxInt(math.MinInt(delegation, delegated.Sub(locked)), math.ZeroInt()), nil
+}
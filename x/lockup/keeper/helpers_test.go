@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestSpendableBondBalanceAndMaxUndelegatable(t *testing.T) {
+	f := SetupTest(t)
+
+	overLocked := f.addrs[0]
+	underLocked := f.addrs[1]
+	val1 := sdk.ValAddress(f.addrs[2])
+	val2 := sdk.ValAddress(overLocked)
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: overLocked.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(1200)},
+			}},
+			{Address: underLocked.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(500)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: overLocked.String(), Amount: math.NewInt(1200)},
+			{UnlockDate: "2026-06-01", Address: underLocked.String(), Amount: math.NewInt(500)},
+		},
+	}))
+	setDelegations(t, f, val1, map[string]int64{overLocked.String(): 600, underLocked.String(): 600})
+	setDelegations(t, f, val2, map[string]int64{overLocked.String(): 300, underLocked.String(): 300})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, overLocked, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, underLocked, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// 300 of the locks are not covered by delegations and stay in the balance
+	res, err := f.queryServer.SpendableBondBalance(ctx, &types.QuerySpendableBondBalanceRequest{Address: overLocked.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 700), res.Spendable)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1000), res.Balance)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1200), res.Locked)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 900), res.Delegated)
+
+	// the send restriction lets exactly the spendable balance through
+	_, err = f.k.SendRestrictionFn(ctx, overLocked, underLocked, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 701)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	_, err = f.k.SendRestrictionFn(ctx, overLocked, underLocked, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)))
+	require.NoError(t, err)
+
+	maxUndelegatable, err := f.queryServer.MaxUndelegatable(ctx, &types.QueryMaxUndelegatableRequest{Address: overLocked.String(), ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.True(t, maxUndelegatable.Amount.IsZero())
+
+	res, err = f.queryServer.SpendableBondBalance(ctx, &types.QuerySpendableBondBalanceRequest{Address: underLocked.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1000), res.Spendable)
+
+	// 400 are delegated above the locks
+	maxUndelegatable, err = f.queryServer.MaxUndelegatable(ctx, &types.QueryMaxUndelegatableRequest{Address: underLocked.String(), ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 400), maxUndelegatable.Amount)
+
+	maxUndelegatable, err = f.queryServer.MaxUndelegatable(ctx, &types.QueryMaxUndelegatableRequest{Address: underLocked.String(), ValidatorAddress: val2.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 300), maxUndelegatable.Amount)
+
+	// the hooks accept undelegating exactly that amount and refuse more
+	txCtx := ctx.WithTxBytes([]byte{1})
+	cacheCtx, _ := txCtx.CacheContext()
+	_, err = f.stakingKeeper.Unbond(cacheCtx, underLocked, val1, math.LegacyNewDec(401))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	_, err = f.stakingKeeper.Unbond(txCtx, underLocked, val1, math.LegacyNewDec(400))
+	require.NoError(t, err)
+}
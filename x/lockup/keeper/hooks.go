@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"sort"
+	"strings"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,25 +16,28 @@ import (
 // redelegatingKey is the context key used to mark move-delegation operations.
 type redelegatingKey struct{}
 
-// redelegatingSet is an immutable set of "delAddr+valSrcAddr" pairs that are
-// being actively moved via MsgBeginRedelegate.
-type redelegatingSet map[string]struct{}
+// redelegatingSet is an immutable map of the "delAddr+valSrcAddr" pairs that
+// are being actively moved via MsgBeginRedelegate to their destination
+// validators.
+type redelegatingSet map[string][]sdk.ValAddress
 
 func redelegateMapKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) string {
 	return string(delAddr) + "\x00" + string(valAddr)
 }
 
 // WithRedelegating returns a context annotated with the (delegator, srcValidator)
-// pair that is being moved. Called by the ante handler for MsgBeginRedelegate so
-// that BeforeDelegationRemoved can skip the invariant check for the source
-// delegation, which will be immediately re-delegated to another validator.
-func WithRedelegating(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr sdk.ValAddress) context.Context {
+// pair that is being moved to dstValidator. Called by the ante handler for
+// MsgBeginRedelegate so that BeforeDelegationRemoved can skip the invariant
+// check for the source delegation, which will be immediately re-delegated to
+// another validator, and so that lock bindings follow the delegation.
+func WithRedelegating(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress) context.Context {
 	existing, _ := ctx.Value(redelegatingKey{}).(redelegatingSet)
 	next := make(redelegatingSet, len(existing)+1)
-	for k := range existing {
-		next[k] = struct{}{}
+	for k, v := range existing {
+		next[k] = v
 	}
-	next[redelegateMapKey(delAddr, valSrcAddr)] = struct{}{}
+	key := redelegateMapKey(delAddr, valSrcAddr)
+	next[key] = append(append([]sdk.ValAddress(nil), existing[key]...), valDstAddr)
 	return context.WithValue(ctx, redelegatingKey{}, next)
 }
 
@@ -43,6 +49,31 @@ func isRedelegating(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.Val
 	return ok
 }
 
+// redelegationSources returns the source validators of the delegations of
+// delAddr being moved to valDstAddr by a MsgBeginRedelegate, in a
+// deterministic order.
+func redelegationSources(ctx context.Context, delAddr sdk.AccAddress, valDstAddr sdk.ValAddress) []sdk.ValAddress {
+	set, _ := ctx.Value(redelegatingKey{}).(redelegatingSet)
+	prefix := string(delAddr) + "\x00"
+
+	var sources []sdk.ValAddress
+	for key, dsts := range set {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		for _, dst := range dsts {
+			if dst.Equals(valDstAddr) {
+				sources = append(sources, sdk.ValAddress(key[len(prefix):]))
+				break
+			}
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return bytes.Compare(sources[i], sources[j]) < 0 })
+
+	return sources
+}
+
 // Hooks wraps the lockup keeper to implement the staking StakingHooks interface.
 // This allows the lockup module to reject undelegations that would cause the
 // total delegated amount to drop below the total locked amount — regardless of
@@ -86,11 +117,24 @@ func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddres
 // the SDK unbonds from the source validator before delegating to the destination,
 // so the total delegated amount is temporarily reduced. The invariant will be
 // enforced once the destination delegation is created.
+//
+// The lock bindings to valAddr are kept within the delegation, and bindings
+// redelegated to valAddr are moved over from the source validator.
 func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	if isRedelegating(ctx, delAddr, valAddr) {
 		return nil
 	}
-	return h.checkLockupInvariant(ctx, delAddr)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delegated, err := h.k.GetDelegationAmount(sdkCtx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+	if err := h.k.syncValidatorBindings(sdkCtx, delAddr, valAddr, delegated); err != nil {
+		return err
+	}
+
+	return h.checkLockupInvariant(ctx, delAddr, valAddr)
 }
 
 // BeforeDelegationRemoved fires when a delegation is about to be fully removed
@@ -104,24 +148,32 @@ func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddre
 // skip the check here: the full amount is being re-delegated to another
 // validator and AfterDelegationModified will enforce the invariant once the
 // destination delegation exists.
+//
+// The lock bindings to valAddr are released with the delegation.
 func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	if isRedelegating(ctx, delAddr, valAddr) {
 		return nil
 	}
-	return h.checkLockupInvariantExcluding(ctx, delAddr, valAddr)
+
+	if err := h.k.syncValidatorBindings(sdk.UnwrapSDKContext(ctx), delAddr, valAddr, math.ZeroInt()); err != nil {
+		return err
+	}
+
+	return h.checkLockupInvariantExcluding(ctx, delAddr, valAddr, true)
 }
 
 // checkLockupInvariant verifies that the delegator's total delegated amount is
 // still >= their total locked amount. Returns an error if the invariant is
-// violated, which causes the staking keeper to abort the operation.
-func (h Hooks) checkLockupInvariant(ctx context.Context, delAddr sdk.AccAddress) error {
-	return h.checkLockupInvariantExcluding(ctx, delAddr, nil)
+// violated by a transaction, which causes the staking keeper to abort the
+// operation.
+func (h Hooks) checkLockupInvariant(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.checkLockupInvariantExcluding(ctx, delAddr, valAddr, false)
 }
 
-// checkLockupInvariantExcluding is the core invariant check. If excludeVal is
-// non-nil, the delegation to that validator is excluded from the total (used by
+// checkLockupInvariantExcluding is the core invariant check. If exclude is
+// set, the delegation to valAddr is excluded from the total (used by
 // BeforeDelegationRemoved where the KV store still has the old record).
-func (h Hooks) checkLockupInvariantExcluding(ctx context.Context, delAddr sdk.AccAddress, excludeVal sdk.ValAddress) error {
+func (h Hooks) checkLockupInvariantExcluding(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, exclude bool) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	totalLocked, err := h.k.GetLockedAmountByAddress(sdkCtx, delAddr)
@@ -141,8 +193,8 @@ func (h Hooks) checkLockupInvariantExcluding(ctx context.Context, delAddr sdk.Ac
 
 	// If we're inside BeforeDelegationRemoved, the delegation being removed
 	// is still in the KV store with its original shares. Subtract it.
-	if excludeVal != nil {
-		excludeAmount, err := h.k.GetDelegationAmount(sdkCtx, delAddr, excludeVal)
+	if exclude {
+		excludeAmount, err := h.k.GetDelegationAmount(sdkCtx, delAddr, valAddr)
 		if err != nil {
 			return err
 		}
@@ -151,6 +203,12 @@ func (h Hooks) checkLockupInvariantExcluding(ctx context.Context, delAddr sdk.Ac
 	}
 
 	if totalDelegated.LT(*totalLocked) {
+		// A slash, e.g. of a redelegation, cannot be refused, so the locks
+		// are reduced to what is still delegated.
+		if isSlashing(ctx) {
+			return h.k.reduceLocksForSlash(sdkCtx, delAddr, totalLocked.Sub(*totalDelegated), valAddr)
+		}
+
 		return types.ErrInsufficientDelegations.Wrapf(
 			"undelegation would cause delegated amount to drop below locked amount: delegated %s < locked %s",
 			totalDelegated.String(),
@@ -161,6 +219,36 @@ func (h Hooks) checkLockupInvariantExcluding(ctx context.Context, delAddr sdk.Ac
 	return nil
 }
 
+// requireLocksCovered verifies the lockup invariant for delAddr and refuses
+// any violation. It is used by lockup messages that move delegations
+// themselves, where the invariant must hold for every party involved.
+func (h Hooks) requireLocksCovered(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	totalLocked, err := h.k.GetLockedAmountByAddress(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if totalLocked.IsZero() {
+		return nil
+	}
+
+	totalDelegated, err := h.k.GetTotalDelegatedAmount(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if totalDelegated.LT(*totalLocked) {
+		return types.ErrInsufficientDelegations.Wrapf(
+			"delegated amount of %s would drop below its locked amount: delegated %s < locked %s",
+			delAddr.String(),
+			totalDelegated.String(),
+			totalLocked.String(),
+		)
+	}
+
+	return nil
+}
+
 // --------------------------------------------------------------------------
 // Validator hooks — no-ops for the lockup module
 // --------------------------------------------------------------------------
@@ -185,8 +273,11 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
-	return nil
+// BeforeValidatorSlashed reduces the locks of the validator's delegators that
+// would no longer be covered by their delegations once the slash is applied.
+// See slashing.go for the policy.
+func (h Hooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	return h.k.slashLocksForValidator(sdk.UnwrapSDKContext(ctx), valAddr, fraction)
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+var _ types.LockupHooks = &recordingHooks{}
+
+// recordingHooks records every lockup hook call as a string
+type recordingHooks struct {
+	calls []string
+}
+
+func (h *recordingHooks) AfterLockCreated(_ context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	h.calls = append(h.calls, fmt.Sprintf("created %s %s %s", addr, types.FormatUnlockTime(unlockTime), amount))
+	return nil
+}
+
+func (h *recordingHooks) AfterLockExtended(_ context.Context, addr sdk.AccAddress, fromTime, toTime time.Time, amount math.Int) error {
+	h.calls = append(h.calls, fmt.Sprintf("extended %s %s %s %s", addr, types.FormatUnlockTime(fromTime), types.FormatUnlockTime(toTime), amount))
+	return nil
+}
+
+func (h *recordingHooks) AfterLockExpired(_ context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	h.calls = append(h.calls, fmt.Sprintf("expired %s %s %s", addr, types.FormatUnlockTime(unlockTime), amount))
+	return nil
+}
+
+func (h *recordingHooks) AfterLockRemoved(_ context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	h.calls = append(h.calls, fmt.Sprintf("removed %s %s %s", addr, types.FormatUnlockTime(unlockTime), amount))
+	return nil
+}
+
+func TestLockupHooks(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+	setDelegations(t, f, valAddr, map[string]int64{addr.String(): 1000})
+	f.accountkeeper.SetAccount(f.ctx, f.accountkeeper.NewAccountWithAddress(f.ctx, addr))
+
+	hooks := &recordingHooks{}
+	f.k.SetHooks(types.NewMultiLockupHooks(hooks))
+	require.Panics(t, func() { f.k.SetHooks(types.NewMultiLockupHooks()) })
+
+	msgServer := keeper.NewMsgServerImpl(f.k)
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	_, err := msgServer.Lock(ctx, types.NewMsgLock(addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 600)))
+	require.NoError(t, err)
+
+	_, err = msgServer.Extend(ctx, &types.MsgExtend{
+		Address: addr.String(),
+		Extensions: []*types.Extension{
+			{FromDate: "2026-06-01", ToDate: "2026-09-01", Amount: sdk.NewInt64Coin(bondDenom, 200)},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.AdjustLock(ctx, &types.MsgAdjustLock{
+		Authority:  f.govModAddr,
+		Address:    addr.String(),
+		UnlockDate: "2026-09-01",
+		Amount:     sdk.NewInt64Coin(bondDenom, 150),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, f.k.EndBlocker(ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))))
+
+	require.Equal(t, []string{
+		fmt.Sprintf("created %s 2026-06-01 600", addr),
+		fmt.Sprintf("extended %s 2026-06-01 2026-09-01 200", addr),
+		fmt.Sprintf("removed %s 2026-09-01 50", addr),
+		fmt.Sprintf("expired %s 2026-06-01 400", addr),
+	}, hooks.calls)
+}
+
+func TestLockScheduleHooks(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	params := types.DefaultParams()
+	params.LockHistoryEnabled = true
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{Params: params}))
+	setDelegations(t, f, valAddr, map[string]int64{})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, from, coins))
+
+	hooks := &recordingHooks{}
+	f.k.SetHooks(types.NewMultiLockupHooks(hooks))
+	msgServer := keeper.NewMsgServerImpl(f.k)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)).WithTxBytes([]byte{1})
+	schedule := types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 4}
+	_, err := msgServer.LockWithSchedule(ctx, types.NewMsgLockWithSchedule(from.String(), to.String(), valAddr.String(), sdk.NewInt64Coin(bondDenom, 1000), schedule))
+	require.NoError(t, err)
+
+	// the first two steps are reported together by the next EndBlocker, the
+	// rest when the schedule ends
+	require.NoError(t, f.k.EndBlocker(ctx.WithBlockTime(time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC))))
+	require.NoError(t, f.k.EndBlocker(ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))))
+
+	require.Equal(t, []string{
+		fmt.Sprintf("created %s 2026-06-01 1000", to),
+		fmt.Sprintf("expired %s 2026-06-01 500", to),
+		fmt.Sprintf("expired %s 2026-06-01 500", to),
+	}, hooks.calls)
+
+	res, err := f.queryServer.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: to.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, types.LOCK_HISTORY_TYPE_CREATED, res.Entries[0].Change)
+	require.Equal(t, types.LOCK_HISTORY_TYPE_EXPIRED, res.Entries[2].Change)
+}
+
+func TestForceUnlockScheduleHooks(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	params := types.DefaultParams()
+	params.LockHistoryEnabled = true
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{Params: params}))
+	setDelegations(t, f, valAddr, map[string]int64{})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, from, coins))
+
+	hooks := &recordingHooks{}
+	f.k.SetHooks(types.NewMultiLockupHooks(hooks))
+	msgServer := keeper.NewMsgServerImpl(f.k)
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)).WithTxBytes([]byte{1})
+	schedule := types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 4}
+	_, err := msgServer.LockWithSchedule(ctx, types.NewMsgLockWithSchedule(from.String(), to.String(), valAddr.String(), sdk.NewInt64Coin(bondDenom, 1000), schedule))
+	require.NoError(t, err)
+
+	// the part of the schedule still locked is removed like a lock
+	ctx = ctx.WithBlockTime(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	res, err := msgServer.ForceUnlock(ctx, types.NewMsgForceUnlock(f.govModAddr, to.String(), "", "compromised key"))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(750), res.Released.Amount)
+
+	require.Equal(t, []string{
+		fmt.Sprintf("created %s 2026-06-01 1000", to),
+		fmt.Sprintf("removed %s 2026-06-01 750", to),
+	}, hooks.calls)
+
+	history, err := f.queryServer.LockHistory(ctx, &types.QueryLockHistoryRequest{Address: to.String()})
+	require.NoError(t, err)
+	require.Len(t, history.Entries, 2)
+	require.Equal(t, types.LOCK_HISTORY_TYPE_REMOVED, history.Entries[1].Change)
+	require.Equal(t, math.NewInt(750), history.Entries[1].Amount)
+}
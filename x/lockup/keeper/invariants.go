@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+const (
+	totalLockedInvariantName        = "total-locked"
+	locksIndexInvariantName         = "locks-index"
+	delegationCoverageInvariantName = "delegation-coverage"
+)
+
+// InvariantResult is the outcome of a single invariant
+type InvariantResult struct {
+	// Name is the invariant route, e.g. "locks-index"
+	Name   string
+	Broken bool
+	// Addresses lists the offending addresses, if the invariant is per address
+	Addresses []string
+	Message   string
+}
+
+// RegisterInvariants registers the lockup module invariants. The app does not
+// run the crisis module, so they are not checked on chain; operators run them
+// against a node's local store with `tscd debug lockup-invariants`.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, totalLockedInvariantName, TotalLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, locksIndexInvariantName, LocksIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, delegationCoverageInvariantName, DelegationCoverageInvariant(k))
+}
+
+// AllInvariants runs all invariants of the lockup module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TotalLockedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = LocksIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DelegationCoverageInvariant(k)(ctx)
+	}
+}
+
+// TotalLockedInvariant checks that the running total-locked aggregate equals
+// the sum of all entries in the expiration queue
+func TotalLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res := k.checkTotalLocked(ctx)
+		return sdk.FormatInvariant(types.ModuleName, res.Name, res.Message), res.Broken
+	}
+}
+
+// LocksIndexInvariant checks that, for every address and unlock date, the
+// per-address lock equals the matching locks_by_date queue entry
+func LocksIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res := k.checkLocksIndex(ctx)
+		return sdk.FormatInvariant(types.ModuleName, res.Name, res.Message), res.Broken
+	}
+}
+
+// DelegationCoverageInvariant checks that every address delegates at least
+// its active locked amount, unless the shortfall is explained by the locks
+// recorded as slashed for the address
+func DelegationCoverageInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res := k.checkDelegationCoverage(ctx)
+		return sdk.FormatInvariant(types.ModuleName, res.Name, res.Message), res.Broken
+	}
+}
+
+// CheckInvariants runs every lockup invariant and returns their results
+func (k Keeper) CheckInvariants(ctx sdk.Context) []InvariantResult {
+	return []InvariantResult{
+		k.checkTotalLocked(ctx),
+		k.checkLocksIndex(ctx),
+		k.checkDelegationCoverage(ctx),
+	}
+}
+
+func (k Keeper) checkTotalLocked(ctx sdk.Context) InvariantResult {
+	res := InvariantResult{Name: totalLockedInvariantName}
+
+	queueTotal := math.ZeroInt()
+	err := k.IterateExpirationQueue(ctx, func(_ sdk.AccAddress, _ time.Time, amount math.Int) error {
+		queueTotal = queueTotal.Add(amount)
+		return nil
+	})
+	if err != nil {
+		return brokenResult(res, fmt.Errorf("failed to iterate expiration queue: %w", err))
+	}
+
+	total, err := k.GetTotalLocked(ctx)
+	if err != nil {
+		return brokenResult(res, fmt.Errorf("failed to read total locked: %w", err))
+	}
+
+	res.Broken = !total.Equal(queueTotal)
+	res.Message = fmt.Sprintf("\ttotal locked aggregate: %s\n\tsum of expiration queue: %s\n", total, queueTotal)
+
+	return res
+}
+
+func (k Keeper) checkLocksIndex(ctx sdk.Context) InvariantResult {
+	res := InvariantResult{Name: locksIndexInvariantName}
+
+	// amounts of the per-address store, keyed like the expiration queue
+	byAddress := make(map[string]math.Int)
+	err := k.LocksByAddress.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, time.Time], amount math.Int) (bool, error) {
+		byAddress[string(k.GetLockExpirationKey(key.K2(), key.K1()))] = amount
+		return false, nil
+	})
+	if err != nil {
+		return brokenResult(res, fmt.Errorf("failed to iterate locks by address: %w", err))
+	}
+
+	offending := make(map[string]struct{})
+	err = k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		key := string(k.GetLockExpirationKey(unlockTime, addr))
+		if lockAmount, found := byAddress[key]; !found || !lockAmount.Equal(amount) {
+			offending[addr.String()] = struct{}{}
+		}
+		delete(byAddress, key)
+		return nil
+	})
+	if err != nil {
+		return brokenResult(res, fmt.Errorf("failed to iterate expiration queue: %w", err))
+	}
+
+	// locks left over have no queue entry
+	for key := range byAddress {
+		addr := sdk.AccAddress(key[len(types.LocksByDateKey)+8:])
+		offending[addr.String()] = struct{}{}
+	}
+
+	res.Addresses = sortedKeys(offending)
+	res.Broken = len(res.Addresses) > 0
+	res.Message = fmt.Sprintf("\t%d addresses with locks not matching the expiration queue\n%s", len(res.Addresses), formatAddresses(res.Addresses))
+
+	return res
+}
+
+func (k Keeper) checkDelegationCoverage(ctx sdk.Context) InvariantResult {
+	res := InvariantResult{Name: delegationCoverageInvariantName}
+
+	offending := make(map[string]struct{})
+	err := k.iterateLockers(ctx, func(addr sdk.AccAddress) error {
+		totalLocked, err := k.GetLockedAmountByAddress(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if totalLocked.IsZero() {
+			return nil
+		}
+
+		totalDelegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if totalDelegated.GTE(*totalLocked) {
+			return nil
+		}
+
+		slashed, err := k.GetSlashedLocks(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if totalLocked.Sub(*totalDelegated).GT(slashed) {
+			offending[addr.String()] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return brokenResult(res, fmt.Errorf("failed to check delegations: %w", err))
+	}
+
+	res.Addresses = sortedKeys(offending)
+	res.Broken = len(res.Addresses) > 0
+	res.Message = fmt.Sprintf("\t%d addresses delegating less than their locked amount\n%s", len(res.Addresses), formatAddresses(res.Addresses))
+
+	return res
+}
+
+func brokenResult(res InvariantResult, err error) InvariantResult {
+	res.Broken = true
+	res.Message = err.Error()
+	return res
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatAddresses(addrs []string) string {
+	var sb strings.Builder
+	for _, addr := range addrs {
+		fmt.Fprintf(&sb, "\t\t%s\n", addr)
+	}
+	return sb.String()
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTotalLockedAggregate(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-02", Amount: math.NewInt(10)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+			{UnlockDate: "2026-01-02", Address: addr2, Amount: math.NewInt(10)},
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(50)},
+		},
+	}))
+
+	total, err := f.k.GetTotalLocked(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(160), total)
+
+	res, err := f.queryServer.TotalLockedAmount(f.ctx, &types.QueryTotalLockedAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(160), res.TotalLocked.Amount)
+
+	_, broken := keeper.TotalLockedInvariant(f.k)(f.ctx)
+	require.False(t, broken)
+
+	// moving an amount between dates leaves the aggregate unchanged
+	from := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, f.k.RemoveFromExpirationQueue(f.ctx, from, f.addrs[0], math.NewInt(20)))
+	require.NoError(t, f.k.AddToExpirationQueue(f.ctx, to, f.addrs[0], math.NewInt(20)))
+
+	total, err = f.k.GetTotalLocked(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(160), total)
+
+	// pruning removes the expired amounts
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	total, err = f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), total)
+
+	_, broken = keeper.TotalLockedInvariant(f.k)(ctx)
+	require.False(t, broken)
+
+	// a drifted aggregate is reported
+	require.NoError(t, f.k.SetTotalLocked(ctx, math.NewInt(1)))
+	_, broken = keeper.TotalLockedInvariant(f.k)(ctx)
+	require.True(t, broken)
+
+	// the aggregate is reseeded from the queue, as the store migration does
+	require.NoError(t, f.k.RecomputeTotalLocked(ctx))
+	total, err = f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), total)
+}
+
+func TestLockupInvariants(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+		},
+	}))
+
+	_, broken := keeper.LocksIndexInvariant(f.k)(f.ctx)
+	require.False(t, broken)
+
+	// the account locks tokens without delegating any of them
+	res := f.k.CheckInvariants(f.ctx)
+	require.Len(t, res, 3)
+	for _, inv := range res {
+		switch inv.Name {
+		case "delegation-coverage":
+			require.True(t, inv.Broken)
+			require.Equal(t, []string{addr1}, inv.Addresses)
+		default:
+			require.False(t, inv.Broken, inv.Name)
+		}
+	}
+
+	// a shortfall within the locks recorded as slashed is not reported
+	require.NoError(t, f.k.SlashedLocks.Set(f.ctx, f.addrs[0], math.NewInt(100)))
+	_, broken = keeper.DelegationCoverageInvariant(f.k)(f.ctx)
+	require.False(t, broken)
+
+	require.NoError(t, f.k.SlashedLocks.Set(f.ctx, f.addrs[0], math.NewInt(99)))
+	_, broken = keeper.DelegationCoverageInvariant(f.k)(f.ctx)
+	require.True(t, broken)
+
+	// once the lock has expired there is nothing left to cover
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	_, broken = keeper.DelegationCoverageInvariant(f.k)(ctx)
+	require.False(t, broken)
+
+	// a per-address lock without a queue entry breaks the index
+	require.NoError(t, f.k.SetLockByAddress(f.ctx, f.addrs[1], &types.Lock{UnlockDate: "2026-03-01", Amount: math.NewInt(5)}))
+
+	for _, inv := range f.k.CheckInvariants(f.ctx) {
+		if inv.Name == "locks-index" {
+			require.True(t, inv.Broken)
+			require.Equal(t, []string{f.addrs[1].String()}, inv.Addresses)
+		}
+	}
+}
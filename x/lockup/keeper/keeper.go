@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 )
@@ -19,11 +25,50 @@ type Keeper struct {
 	storeService storetypes.KVStoreService
 	logger       log.Logger
 
+	// state management
+	Schema         collections.Schema
+	Params         collections.Item[types.Params]
+	LocksByAddress collections.Map[collections.Pair[sdk.AccAddress, time.Time], math.Int]
+	// SlashedLocks records the lock amount removed from each address
+	// because of validator slashes
+	SlashedLocks collections.Map[sdk.AccAddress, math.Int]
+	// LockSchedules holds the locks released by schedule
+	LockSchedules        collections.Map[collections.Pair[sdk.AccAddress, uint64], types.LockSchedule]
+	LockScheduleSequence collections.Sequence
+	// LockSchedulesByRelease indexes LockSchedules by the release time of
+	// their next step
+	LockSchedulesByRelease collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, uint64]]
+	// ScheduledLocked is the amount of all lock schedules locked at
+	// ScheduledLockedTime, the block time their releases were last processed
+	ScheduledLocked     collections.Item[math.Int]
+	ScheduledLockedTime collections.Item[time.Time]
+	// LockBindings holds the part of each lock bound to a validator
+	LockBindings collections.Map[collections.Triple[sdk.AccAddress, time.Time, sdk.ValAddress], math.Int]
+	// LockBindingsByValidator indexes LockBindings by validator
+	LockBindingsByValidator collections.KeySet[collections.Triple[sdk.ValAddress, sdk.AccAddress, time.Time]]
+	// ValidatorBoundTotals holds the amount of all LockBindings to each
+	// validator
+	ValidatorBoundTotals collections.Map[sdk.ValAddress, math.Int]
+	// LockHistoryEntries holds the recorded lock changes of each address
+	LockHistoryEntries  collections.Map[collections.Pair[sdk.AccAddress, uint64], types.LockHistoryEntry]
+	LockHistorySequence collections.Sequence
+	// LockHistoryByTime indexes LockHistoryEntries by block time
+	LockHistoryByTime collections.KeySet[collections.Triple[time.Time, sdk.AccAddress, uint64]]
+	// LockAllowances holds the allowances to lock on behalf of an owner,
+	// keyed by (owner, spender)
+	LockAllowances collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.LockAllowance]
+	// LockTransferAcceptances holds the senders each address accepts lock
+	// transfers from, keyed by (address, sender)
+	LockTransferAcceptances collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+
 	authority string
 
+	hooks types.LockupHooks
+
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	distrKeeper   types.DistributionKeeper
 }
 
 // NewKeeper creates a new Keeper instance
@@ -35,9 +80,12 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	logger = logger.With(log.ModuleKey, "x/"+types.ModuleName)
 
+	sb := collections.NewSchemaBuilder(storeService)
+
 	if authority == "" {
 		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 	}
@@ -47,13 +95,100 @@ func NewKeeper(
 		storeService: storeService,
 		logger:       logger,
 
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		LocksByAddress: collections.NewMap(
+			sb,
+			types.LocksByAddressKey,
+			"locks_by_address",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.TimeKey),
+			sdk.IntValue,
+		),
+		SlashedLocks: collections.NewMap(
+			sb,
+			types.SlashedLocksKey,
+			"slashed_locks",
+			sdk.AccAddressKey,
+			sdk.IntValue,
+		),
+		LockSchedules: collections.NewMap(
+			sb,
+			types.LockSchedulesKey,
+			"lock_schedules",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.LockSchedule](cdc),
+		),
+		LockScheduleSequence: collections.NewSequence(sb, types.LockScheduleSequenceKey, "lock_schedule_sequence"),
+		LockSchedulesByRelease: collections.NewKeySet(
+			sb,
+			types.LockSchedulesByReleaseKey,
+			"lock_schedules_by_release",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		ScheduledLocked:     collections.NewItem(sb, types.ScheduledLockedKey, "scheduled_locked", sdk.IntValue),
+		ScheduledLockedTime: collections.NewItem(sb, types.ScheduledLockedTimeKey, "scheduled_locked_time", collcodec.KeyToValueCodec(sdk.TimeKey)),
+		LockBindings: collections.NewMap(
+			sb,
+			types.LockBindingsKey,
+			"lock_bindings",
+			collections.TripleKeyCodec(sdk.AccAddressKey, sdk.TimeKey, sdk.ValAddressKey),
+			sdk.IntValue,
+		),
+		LockBindingsByValidator: collections.NewKeySet(
+			sb,
+			types.LockBindingsByValidatorKey,
+			"lock_bindings_by_validator",
+			collections.TripleKeyCodec(sdk.ValAddressKey, sdk.AccAddressKey, sdk.TimeKey),
+		),
+		ValidatorBoundTotals: collections.NewMap(
+			sb,
+			types.ValidatorBoundTotalsKey,
+			"validator_bound_totals",
+			sdk.ValAddressKey,
+			sdk.IntValue,
+		),
+		LockHistoryEntries: collections.NewMap(
+			sb,
+			types.LockHistoryKey,
+			"lock_history",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.LockHistoryEntry](cdc),
+		),
+		LockHistorySequence: collections.NewSequence(sb, types.LockHistorySequenceKey, "lock_history_sequence"),
+		LockHistoryByTime: collections.NewKeySet(
+			sb,
+			types.LockHistoryByTimeKey,
+			"lock_history_by_time",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		LockAllowances: collections.NewMap(
+			sb,
+			types.LockAllowancesKey,
+			"lock_allowances",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.LockAllowance](cdc),
+		),
+		LockTransferAcceptances: collections.NewKeySet(
+			sb,
+			types.LockTransferAcceptancesKey,
+			"lock_transfer_acceptances",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+
 		authority: authority,
 
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		distrKeeper:   distrKeeper,
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
 
+	k.Schema = schema
+
 	return k
 }
 
@@ -61,8 +196,272 @@ func (k Keeper) Logger() log.Logger {
 	return k.logger
 }
 
+// SetHooks sets the lockup hooks. It must be called before the keeper is
+// copied into the modules that use it.
+func (k *Keeper) SetHooks(lh types.LockupHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set lockup hooks twice")
+	}
+
+	k.hooks = lh
+
+	return k
+}
+
+// LockupHooks returns the lockup hooks, a no-op set if none were set.
+func (k Keeper) LockupHooks() types.LockupHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiLockupHooks{}
+	}
+
+	return k.hooks
+}
+
+// GetParams returns the module params. Chains that were running the module
+// before params existed have nothing stored yet, so the defaults (which match
+// the previously hardcoded rules) are returned in that case.
+func (k Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DefaultParams(), nil
+	}
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	return params, nil
+}
+
 // InitGenesis initializes the module's state from a genesis state.
-func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error { return nil }
+// Both the per-address lock records and the expiration queue are rebuilt
+// from the genesis data.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.Params.Set(ctx, data.Params); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, accountLocks := range data.AccountLocks {
+		addr, err := sdk.AccAddressFromBech32(accountLocks.Address)
+		if err != nil {
+			return err
+		}
+
+		for _, lock := range accountLocks.Locks {
+			if err := k.SetLockByAddress(sdkCtx, addr, lock); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, entry := range data.ExpirationQueue {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return err
+		}
+
+		unlockTime, err := types.ParseUnlockTime(entry.UnlockDate)
+		if err != nil {
+			return err
+		}
+
+		if err := k.AddToExpirationQueue(ctx, unlockTime, addr, entry.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, adjustment := range data.SlashAdjustments {
+		addr, err := sdk.AccAddressFromBech32(adjustment.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SlashedLocks.Set(ctx, addr, adjustment.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, schedule := range data.LockSchedules {
+		addr, err := sdk.AccAddressFromBech32(schedule.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.LockSchedules.Set(ctx, collections.Join(addr, schedule.Id), schedule); err != nil {
+			return err
+		}
+	}
+
+	if err := k.RecomputeScheduledLocked(sdkCtx); err != nil {
+		return err
+	}
+
+	for _, binding := range data.LockBindings {
+		addr, err := sdk.AccAddressFromBech32(binding.Address)
+		if err != nil {
+			return err
+		}
+
+		unlockTime, err := types.ParseUnlockTime(binding.UnlockDate)
+		if err != nil {
+			return err
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(binding.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setLockBinding(sdkCtx, addr, unlockTime, valAddr, binding.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.LockHistory {
+		addr, err := sdk.AccAddressFromBech32(entry.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SetLockHistoryEntry(sdkCtx, addr, entry); err != nil {
+			return err
+		}
+	}
+
+	if err := k.LockHistorySequence.Set(ctx, data.NextHistoryId); err != nil {
+		return err
+	}
+
+	for _, allowance := range data.LockAllowances {
+		if err := k.setLockAllowance(ctx, allowance); err != nil {
+			return err
+		}
+	}
+
+	for _, acceptance := range data.LockTransferAcceptances {
+		addr, err := sdk.AccAddressFromBech32(acceptance.Address)
+		if err != nil {
+			return err
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(acceptance.FromAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SetLockTransferAcceptance(ctx, addr, fromAddr, true); err != nil {
+			return err
+		}
+	}
+
+	return k.LockScheduleSequence.Set(ctx, data.NextScheduleId)
+}
 
 // ExportGenesis exports the module's state to a genesis state.
-func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState { return &types.GenesisState{} }
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genState := &types.GenesisState{
+		Params: params,
+	}
+
+	err = k.IterateLocksByAddress(sdkCtx, func(addr sdk.AccAddress, locks []*types.Lock) error {
+		genState.AccountLocks = append(genState.AccountLocks, types.AccountLocks{
+			Address: addr.String(),
+			Locks:   locks,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateExpirationQueue(ctx, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		genState.ExpirationQueue = append(genState.ExpirationQueue, types.ExpirationQueueEntry{
+			UnlockDate: types.FormatUnlockTime(unlockTime),
+			Address:    addr.String(),
+			Amount:     amount,
+		})
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.SlashedLocks.Walk(ctx, nil, func(addr sdk.AccAddress, amount math.Int) (bool, error) {
+		genState.SlashAdjustments = append(genState.SlashAdjustments, types.SlashAdjustment{
+			Address: addr.String(),
+			Amount:  amount,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.LockSchedules.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], schedule types.LockSchedule) (bool, error) {
+		genState.LockSchedules = append(genState.LockSchedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState.NextScheduleId, err = k.LockScheduleSequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.IterateLockBindings(sdkCtx, func(binding types.LockBinding) error {
+		genState.LockBindings = append(genState.LockBindings, binding)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.LockHistoryEntries.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], entry types.LockHistoryEntry) (bool, error) {
+		genState.LockHistory = append(genState.LockHistory, entry)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState.NextHistoryId, err = k.LockHistorySequence.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.LockAllowances.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], allowance types.LockAllowance) (bool, error) {
+		genState.LockAllowances = append(genState.LockAllowances, allowance)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.LockTransferAcceptances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		genState.LockTransferAcceptances = append(genState.LockTransferAcceptances, types.LockTransferAcceptance{
+			Address:     key.K1().String(),
+			FromAddress: key.K2().String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Lock allowances
+//
+// An owner can allow a spender, typically a contract, to lock and extend its
+// tokens through the lockup precompile. Every lock or extension made by the
+// spender uses up its amount from the allowance, and its unlock date must not
+// be after the max unlock date of the allowance; an extension must keep both
+// the lock it moves and its new unlock date within it. An allowance used up
+// entirely is removed.
+
+// setLockAllowance stores an allowance, removing it when its amount is zero
+func (k Keeper) setLockAllowance(ctx context.Context, allowance types.LockAllowance) error {
+	owner, err := sdk.AccAddressFromBech32(allowance.Owner)
+	if err != nil {
+		return err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(allowance.Spender)
+	if err != nil {
+		return err
+	}
+
+	if !allowance.MaxAmount.IsPositive() {
+		return k.LockAllowances.Remove(ctx, collections.Join(owner, spender))
+	}
+
+	return k.LockAllowances.Set(ctx, collections.Join(owner, spender), allowance)
+}
+
+// SetLockAllowance allows spender to lock and extend up to maxAmount of the
+// tokens of owner until maxUnlockTime, replacing any previous allowance. A
+// zero maxAmount revokes the allowance.
+func (k Keeper) SetLockAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, maxAmount math.Int, maxUnlockTime time.Time) error {
+	if maxAmount.IsNegative() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "lock allowance amount must not be negative: %s", maxAmount)
+	}
+
+	return k.setLockAllowance(ctx, types.LockAllowance{
+		Owner:         owner.String(),
+		Spender:       spender.String(),
+		MaxAmount:     maxAmount,
+		MaxUnlockDate: types.FormatUnlockTime(maxUnlockTime),
+	})
+}
+
+// GetLockAllowance returns the allowance of spender to lock on behalf of owner
+func (k Keeper) GetLockAllowance(ctx sdk.Context, owner, spender sdk.AccAddress) (types.LockAllowance, bool, error) {
+	allowance, err := k.LockAllowances.Get(ctx, collections.Join(owner, spender))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.LockAllowance{}, false, nil
+	}
+	if err != nil {
+		return types.LockAllowance{}, false, err
+	}
+
+	return allowance, true, nil
+}
+
+// RevokeLockAllowance removes the allowance of spender to lock on behalf of
+// owner
+func (k Keeper) RevokeLockAllowance(ctx sdk.Context, owner, spender sdk.AccAddress) error {
+	return k.LockAllowances.Remove(ctx, collections.Join(owner, spender))
+}
+
+// UseLockAllowance uses amount of the allowance of spender to lock on behalf
+// of owner until unlockTime
+func (k Keeper) UseLockAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, amount math.Int, unlockTime time.Time) error {
+	return k.useLockAllowance(ctx, owner, spender, amount, unlockTime)
+}
+
+// UseLockExtensionAllowance uses amount of the allowance of spender to move
+// a lock of owner from fromTime to toTime. Both unlock dates must be within
+// the allowance, so that the spender only moves locks the owner let it lock.
+func (k Keeper) UseLockExtensionAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, amount math.Int, fromTime, toTime time.Time) error {
+	return k.useLockAllowance(ctx, owner, spender, amount, fromTime, toTime)
+}
+
+// useLockAllowance uses amount of the allowance of spender on behalf of owner
+// after checking that none of unlockTimes is after its max unlock date
+func (k Keeper) useLockAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, amount math.Int, unlockTimes ...time.Time) error {
+	allowance, found, err := k.GetLockAllowance(ctx, owner, spender)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errorsmod.Wrapf(types.ErrInsufficientLockAllowance, "%s has no lock allowance of %s", spender, owner)
+	}
+
+	maxUnlockTime, err := types.ParseUnlockTime(allowance.MaxUnlockDate)
+	if err != nil {
+		return err
+	}
+
+	for _, unlockTime := range unlockTimes {
+		if unlockTime.After(maxUnlockTime) {
+			return errorsmod.Wrapf(types.ErrInsufficientLockAllowance, "unlock date %s is after the max unlock date %s", types.FormatUnlockTime(unlockTime), allowance.MaxUnlockDate)
+		}
+	}
+
+	if amount.GT(allowance.MaxAmount) {
+		return errorsmod.Wrapf(types.ErrInsufficientLockAllowance, "amount %s exceeds the allowance %s", amount, allowance.MaxAmount)
+	}
+
+	allowance.MaxAmount = allowance.MaxAmount.Sub(amount)
+
+	return k.setLockAllowance(ctx, allowance)
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLockAllowances(t *testing.T) {
+	f := SetupTest(t)
+
+	owner := f.addrs[0]
+	spender := f.addrs[1]
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	maxUnlockTime := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	// nothing can be used without an allowance
+	err := f.k.UseLockAllowance(ctx, owner, spender, math.NewInt(1), maxUnlockTime)
+	require.ErrorIs(t, err, types.ErrInsufficientLockAllowance)
+
+	require.NoError(t, f.k.SetLockAllowance(ctx, owner, spender, math.NewInt(500), maxUnlockTime))
+
+	res, err := f.queryServer.LockAllowance(ctx, &types.QueryLockAllowanceRequest{Owner: owner.String(), Spender: spender.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.LockAllowance{
+		Owner:         owner.String(),
+		Spender:       spender.String(),
+		MaxAmount:     math.NewInt(500),
+		MaxUnlockDate: "2026-12-31",
+	}, res.Allowance)
+
+	// the allowance only goes one way
+	_, err = f.queryServer.LockAllowance(ctx, &types.QueryLockAllowanceRequest{Owner: spender.String(), Spender: owner.String()})
+	require.Error(t, err)
+
+	require.NoError(t, f.k.UseLockAllowance(ctx, owner, spender, math.NewInt(200), time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)))
+
+	err = f.k.UseLockAllowance(ctx, owner, spender, math.NewInt(100), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, types.ErrInsufficientLockAllowance)
+
+	err = f.k.UseLockAllowance(ctx, owner, spender, math.NewInt(301), maxUnlockTime)
+	require.ErrorIs(t, err, types.ErrInsufficientLockAllowance)
+
+	// an extension must keep the lock it moves within the allowance too
+	err = f.k.UseLockExtensionAllowance(ctx, owner, spender, math.NewInt(100), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, types.ErrInsufficientLockAllowance)
+
+	require.NoError(t, f.k.UseLockExtensionAllowance(ctx, owner, spender, math.NewInt(100), time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), maxUnlockTime))
+
+	allowance, found, err := f.k.GetLockAllowance(ctx, owner, spender)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(200), allowance.MaxAmount)
+
+	// the allowance survives a genesis round trip
+	genState := f.k.ExportGenesis(ctx)
+	require.Equal(t, []types.LockAllowance{allowance}, genState.LockAllowances)
+	require.NoError(t, genState.Validate())
+
+	genState.LockAllowances = append(genState.LockAllowances, allowance)
+	require.Error(t, genState.Validate())
+
+	// an allowance used up entirely is removed
+	require.NoError(t, f.k.UseLockAllowance(ctx, owner, spender, math.NewInt(200), maxUnlockTime))
+
+	_, found, err = f.k.GetLockAllowance(ctx, owner, spender)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	require.NoError(t, f.k.SetLockAllowance(ctx, owner, spender, math.NewInt(100), maxUnlockTime))
+	require.NoError(t, f.k.RevokeLockAllowance(ctx, owner, spender))
+
+	_, found, err = f.k.GetLockAllowance(ctx, owner, spender)
+	require.NoError(t, err)
+	require.False(t, found)
+}
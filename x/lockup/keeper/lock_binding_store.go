@@ -0,0 +1,283 @@
+package keeper
+
+import (
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Lock bindings
+//
+// Part of a lock can be bound to the validator its tokens are delegated to.
+// A binding never exceeds its lock, nor the delegation to its validator: when
+// either shrinks, the bindings are trimmed with it. The unbound part of a lock
+// is always released first. Redelegations marked by the ante handler carry
+// the bindings of the source validator over to the destination. The total
+// bound to each validator is kept with the bindings, so that it is read
+// without walking them.
+
+// lockBinding is the part of a lock bound to a validator
+type lockBinding struct {
+	unlockTime time.Time
+	valAddr    sdk.ValAddress
+	amount     math.Int
+}
+
+// bindingKey builds the LockBindings key of a lock and validator
+func bindingKey(addr sdk.AccAddress, unlockTime time.Time, valAddr sdk.ValAddress) collections.Triple[sdk.AccAddress, time.Time, sdk.ValAddress] {
+	return collections.Join3(addr, unlockTime, valAddr)
+}
+
+// setLockBinding stores the amount of the lock of addr on unlockTime bound to
+// valAddr, removing the binding when the amount is zero. Every binding is
+// written here, so the bound total of valAddr is updated here as well.
+func (k Keeper) setLockBinding(ctx sdk.Context, addr sdk.AccAddress, unlockTime time.Time, valAddr sdk.ValAddress, amount math.Int) error {
+	bound, err := k.getLockBinding(ctx, addr, unlockTime, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.addValidatorBoundTotal(ctx, valAddr, math.MaxInt(amount, math.ZeroInt()).Sub(bound)); err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() {
+		if err := k.LockBindings.Remove(ctx, bindingKey(addr, unlockTime, valAddr)); err != nil {
+			return err
+		}
+		return k.LockBindingsByValidator.Remove(ctx, collections.Join3(valAddr, addr, unlockTime))
+	}
+
+	if err := k.LockBindings.Set(ctx, bindingKey(addr, unlockTime, valAddr), amount); err != nil {
+		return err
+	}
+	return k.LockBindingsByValidator.Set(ctx, collections.Join3(valAddr, addr, unlockTime))
+}
+
+// getLockBinding returns the amount of the lock of addr on unlockTime bound
+// to valAddr
+func (k Keeper) getLockBinding(ctx sdk.Context, addr sdk.AccAddress, unlockTime time.Time, valAddr sdk.ValAddress) (math.Int, error) {
+	amount, err := k.LockBindings.Get(ctx, bindingKey(addr, unlockTime, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return amount, err
+}
+
+// bindLock binds amount more of the lock of addr on unlockTime to valAddr
+func (k Keeper) bindLock(ctx sdk.Context, addr sdk.AccAddress, unlockTime time.Time, valAddr sdk.ValAddress, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	bound, err := k.getLockBinding(ctx, addr, unlockTime, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.setLockBinding(ctx, addr, unlockTime, valAddr, bound.Add(amount))
+}
+
+// getLockBindings returns the bindings of the lock of addr on unlockTime,
+// ordered by validator
+func (k Keeper) getLockBindings(ctx sdk.Context, addr sdk.AccAddress, unlockTime time.Time) ([]lockBinding, error) {
+	rng := collections.NewSuperPrefixedTripleRange[sdk.AccAddress, time.Time, sdk.ValAddress](addr, unlockTime)
+
+	var bindings []lockBinding
+	err := k.LockBindings.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, time.Time, sdk.ValAddress], amount math.Int) (bool, error) {
+		bindings = append(bindings, lockBinding{unlockTime: key.K2(), valAddr: key.K3(), amount: amount})
+		return false, nil
+	})
+
+	return bindings, err
+}
+
+// getValidatorBindings returns the bindings of the locks of addr to valAddr,
+// ordered by unlock time
+func (k Keeper) getValidatorBindings(ctx sdk.Context, addr sdk.AccAddress, valAddr sdk.ValAddress) ([]lockBinding, error) {
+	rng := collections.NewPrefixedTripleRange[sdk.AccAddress, time.Time, sdk.ValAddress](addr)
+
+	var bindings []lockBinding
+	err := k.LockBindings.Walk(ctx, rng, func(key collections.Triple[sdk.AccAddress, time.Time, sdk.ValAddress], amount math.Int) (bool, error) {
+		if key.K3().Equals(valAddr) {
+			bindings = append(bindings, lockBinding{unlockTime: key.K2(), valAddr: key.K3(), amount: amount})
+		}
+		return false, nil
+	})
+
+	return bindings, err
+}
+
+// GetBoundAmount returns the amount of the active locks of addr bound to
+// valAddr
+func (k Keeper) GetBoundAmount(ctx sdk.Context, addr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	bindings, err := k.getValidatorBindings(ctx, addr, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	bound := math.ZeroInt()
+	for _, binding := range bindings {
+		if blockTime.Before(binding.unlockTime) {
+			bound = bound.Add(binding.amount)
+		}
+	}
+
+	return bound, nil
+}
+
+// trimLockBindings reduces the bindings of the lock of addr on unlockTime so
+// that they add up to at most lockAmount. The binding to preferVal, if any,
+// is cut first and the others in validator order. The removed parts are
+// returned.
+func (k Keeper) trimLockBindings(ctx sdk.Context, addr sdk.AccAddress, unlockTime time.Time, lockAmount math.Int, preferVal sdk.ValAddress) ([]lockBinding, error) {
+	bindings, err := k.getLockBindings(ctx, addr, unlockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	excess := math.ZeroInt()
+	for _, binding := range bindings {
+		excess = excess.Add(binding.amount)
+	}
+	excess = excess.Sub(lockAmount)
+	if !excess.IsPositive() {
+		return nil, nil
+	}
+
+	if preferVal != nil {
+		for i, binding := range bindings {
+			if binding.valAddr.Equals(preferVal) {
+				bindings[0], bindings[i] = bindings[i], bindings[0]
+				break
+			}
+		}
+	}
+
+	return k.cutBindings(ctx, addr, bindings, excess)
+}
+
+// trimValidatorBindings reduces the bindings of addr to valAddr so that they
+// add up to at most delegated, cutting the latest unlock times first. The
+// removed parts are returned.
+func (k Keeper) trimValidatorBindings(ctx sdk.Context, addr sdk.AccAddress, valAddr sdk.ValAddress, delegated math.Int) ([]lockBinding, error) {
+	bindings, err := k.getValidatorBindings(ctx, addr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	excess := math.ZeroInt()
+	for _, binding := range bindings {
+		excess = excess.Add(binding.amount)
+	}
+	excess = excess.Sub(delegated)
+	if !excess.IsPositive() {
+		return nil, nil
+	}
+
+	for i, j := 0, len(bindings)-1; i < j; i, j = i+1, j-1 {
+		bindings[i], bindings[j] = bindings[j], bindings[i]
+	}
+
+	return k.cutBindings(ctx, addr, bindings, excess)
+}
+
+// cutBindings removes amount from the given bindings of addr, in order, and
+// returns the removed parts
+func (k Keeper) cutBindings(ctx sdk.Context, addr sdk.AccAddress, bindings []lockBinding, amount math.Int) ([]lockBinding, error) {
+	var cuts []lockBinding
+	for _, binding := range bindings {
+		if !amount.IsPositive() {
+			break
+		}
+
+		cut := math.MinInt(amount, binding.amount)
+		if err := k.setLockBinding(ctx, addr, binding.unlockTime, binding.valAddr, binding.amount.Sub(cut)); err != nil {
+			return nil, err
+		}
+
+		cuts = append(cuts, lockBinding{unlockTime: binding.unlockTime, valAddr: binding.valAddr, amount: cut})
+		amount = amount.Sub(cut)
+	}
+
+	return cuts, nil
+}
+
+// syncValidatorBindings keeps the bindings of delAddr to valAddr within its
+// delegation to valAddr. If valAddr is the destination of a marked
+// redelegation, the bindings the source delegation no longer covers are
+// moved to valAddr first.
+func (k Keeper) syncValidatorBindings(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, delegated math.Int) error {
+	for _, srcAddr := range redelegationSources(ctx, delAddr, valAddr) {
+		srcDelegated, err := k.GetDelegationAmount(ctx, delAddr, srcAddr)
+		if err != nil {
+			return err
+		}
+
+		moved, err := k.trimValidatorBindings(ctx, delAddr, srcAddr, srcDelegated)
+		if err != nil {
+			return err
+		}
+
+		for _, binding := range moved {
+			if err := k.bindLock(ctx, delAddr, binding.unlockTime, valAddr, binding.amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := k.trimValidatorBindings(ctx, delAddr, valAddr, delegated)
+	return err
+}
+
+// addValidatorBoundTotal adds amount, which may be negative, to the bound
+// total of valAddr, removing the total once it is zero
+func (k Keeper) addValidatorBoundTotal(ctx sdk.Context, valAddr sdk.ValAddress, amount math.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	total, err := k.GetValidatorBoundTotal(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	total = total.Add(amount)
+	if !total.IsPositive() {
+		return k.ValidatorBoundTotals.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorBoundTotals.Set(ctx, valAddr, total)
+}
+
+// GetValidatorBoundTotal returns the amount of all lock bindings to valAddr.
+// The bindings of expired locks are removed with their locks at the end of
+// the block, so the total of committed state only counts active locks.
+func (k Keeper) GetValidatorBoundTotal(ctx sdk.Context, valAddr sdk.ValAddress) (math.Int, error) {
+	total, err := k.ValidatorBoundTotals.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return total, err
+}
+
+// IterateLockBindings iterates over all lock bindings
+func (k Keeper) IterateLockBindings(ctx sdk.Context, cb func(binding types.LockBinding) error) error {
+	return k.LockBindings.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, time.Time, sdk.ValAddress], amount math.Int) (bool, error) {
+		return false, cb(types.LockBinding{
+			Address:          key.K1().String(),
+			UnlockDate:       types.FormatUnlockTime(key.K2()),
+			ValidatorAddress: key.K3().String(),
+			Amount:           amount,
+		})
+	})
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLockBindings(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	val1 := sdk.ValAddress(f.addrs[1])
+	val2 := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+	setDelegations(t, f, val1, map[string]int64{addr.String(): 1000})
+	setDelegations(t, f, val2, map[string]int64{addr.String(): 500})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	lock := types.NewMsgLock(addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 600))
+	lock.ValidatorAddress = val1.String()
+	_, err := f.msgServer.Lock(ctx, lock)
+	require.NoError(t, err)
+
+	// more than what is left delegated to the validator
+	lock = types.NewMsgLock(addr.String(), "2026-09-01", sdk.NewInt64Coin(bondDenom, 500))
+	lock.ValidatorAddress = val1.String()
+	_, err = f.msgServer.Lock(ctx, lock)
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	lock.ValidatorAddress = val2.String()
+	lock.Amount = sdk.NewInt64Coin(bondDenom, 200)
+	_, err = f.msgServer.Lock(ctx, lock)
+	require.NoError(t, err)
+
+	res, err := f.queryServer.LocksByValidator(ctx, &types.QueryLocksByValidatorRequest{ValidatorAddress: val1.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.LockBinding{
+		{Address: addr.String(), UnlockDate: "2026-06-01", ValidatorAddress: val1.String(), Amount: math.NewInt(600)},
+	}, res.Locks)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 600), res.TotalLocked)
+
+	// undelegating trims the binding to what is left delegated
+	_, err = f.stakingKeeper.Unbond(ctx, addr, val1, math.LegacyNewDec(700))
+	require.NoError(t, err)
+
+	bound, err := f.k.GetBoundAmount(ctx, addr, val1)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(300), bound)
+
+	// a redelegation carries the binding over to the destination
+	redelegateCtx := ctx.WithContext(keeper.WithRedelegating(ctx, addr, val1, val2))
+	amount, err := f.stakingKeeper.Unbond(redelegateCtx, addr, val1, math.LegacyNewDec(300))
+	require.NoError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, val2)
+	require.NoError(t, err)
+	_, err = f.stakingKeeper.Delegate(redelegateCtx, addr, amount, validator.GetStatus(), validator, false)
+	require.NoError(t, err)
+
+	bound, err = f.k.GetBoundAmount(ctx, addr, val1)
+	require.NoError(t, err)
+	require.True(t, bound.IsZero())
+
+	res, err = f.queryServer.LocksByValidator(ctx, &types.QueryLocksByValidatorRequest{ValidatorAddress: val2.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.LockBinding{
+		{Address: addr.String(), UnlockDate: "2026-06-01", ValidatorAddress: val2.String(), Amount: math.NewInt(300)},
+		{Address: addr.String(), UnlockDate: "2026-09-01", ValidatorAddress: val2.String(), Amount: math.NewInt(200)},
+	}, res.Locks)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 500), res.TotalLocked)
+
+	// pages stay within the bindings of the validator
+	res, err = f.queryServer.LocksByValidator(ctx, &types.QueryLocksByValidatorRequest{
+		ValidatorAddress: val2.String(),
+		Pagination:       &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.Equal(t, "2026-06-01", res.Locks[0].UnlockDate)
+
+	res, err = f.queryServer.LocksByValidator(ctx, &types.QueryLocksByValidatorRequest{
+		ValidatorAddress: val2.String(),
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Locks, 1)
+	require.Equal(t, "2026-09-01", res.Locks[0].UnlockDate)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// the bindings survive a genesis round trip
+	genState := f.k.ExportGenesis(ctx)
+	require.Len(t, genState.LockBindings, 2)
+	require.NoError(t, genState.Validate())
+
+	genState.LockBindings[0].Amount = math.NewInt(700)
+	require.Error(t, genState.Validate())
+}
+
+// requireBoundTotal checks the bound total of valAddr against both expected
+// and the bindings to valAddr
+func requireBoundTotal(t *testing.T, f *testFixture, ctx sdk.Context, valAddr sdk.ValAddress, expected int64) {
+	t.Helper()
+
+	res, err := f.queryServer.LocksByValidator(ctx, &types.QueryLocksByValidatorRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(expected), res.TotalLocked.Amount)
+
+	bound := math.ZeroInt()
+	for _, binding := range res.Locks {
+		bound = bound.Add(binding.Amount)
+	}
+	require.Equal(t, bound, res.TotalLocked.Amount)
+}
+
+func TestValidatorBoundTotals(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+	setDelegations(t, f, valAddr, map[string]int64{from.String(): 700})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	requireBoundTotal(t, f, ctx, valAddr, 0)
+
+	for _, lock := range []*types.MsgLock{
+		types.NewMsgLock(from.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 400)),
+		types.NewMsgLock(from.String(), "2026-09-01", sdk.NewInt64Coin(bondDenom, 300)),
+	} {
+		lock.ValidatorAddress = valAddr.String()
+		_, err := f.msgServer.Lock(ctx, lock)
+		require.NoError(t, err)
+	}
+	requireBoundTotal(t, f, ctx, valAddr, 700)
+
+	// a transfer moves the binding to the recipient
+	_, err := f.msgServer.AcceptLockTransfers(ctx, types.NewMsgAcceptLockTransfers(to.String(), from.String(), true))
+	require.NoError(t, err)
+	_, err = f.msgServer.TransferLock(ctx, types.NewMsgTransferLock(from.String(), to.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 100), valAddr.String()))
+	require.NoError(t, err)
+
+	bound, err := f.k.GetBoundAmount(ctx, to, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), bound)
+	requireBoundTotal(t, f, ctx, valAddr, 700)
+
+	// a slash cuts the locks delegated to the validator and their bindings
+	require.NoError(t, f.k.Hooks().BeforeValidatorSlashed(ctx, valAddr, math.LegacyNewDecWithPrec(1, 1)))
+	requireBoundTotal(t, f, ctx, valAddr, 630)
+
+	// a force unlock removes the bindings of the released lock
+	_, err = f.msgServer.ForceUnlock(ctx, types.NewMsgForceUnlock(f.govModAddr, from.String(), "2026-09-01", "legal order"))
+	require.NoError(t, err)
+	requireBoundTotal(t, f, ctx, valAddr, 360)
+
+	// the total is rebuilt from the bindings of a genesis
+	genState := f.k.ExportGenesis(ctx)
+	g := SetupTest(t)
+	require.NoError(t, g.k.InitGenesis(g.ctx, genState))
+	total, err := g.k.GetValidatorBoundTotal(g.ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(360), total)
+
+	// expired locks take their bindings with them
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+	requireBoundTotal(t, f, ctx, valAddr, 0)
+
+	_, err = f.k.ValidatorBoundTotals.Get(ctx, valAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Lock history
+//
+// While lock_history_enabled is set, every change to the locks of an address
+// is recorded with the block height, block time and hash of the transaction
+// that made it, so that the lifecycle of a lock can be rebuilt from the chain
+// alone. A lock schedule is recorded as a lock unlocking on its last release:
+// its creation as created, each released step as expired and the part taken
+// by a slash or a force unlock as removed. Entries older than
+// lock_history_retention_days are pruned by the EndBlocker, also after the
+// history has been disabled, at most MaxLockHistoryPrunesPerBlock in a block.
+
+// MaxLockHistoryPrunesPerBlock is the most lock history entries pruned in a
+// block. Older entries left over are pruned in the following blocks.
+const MaxLockHistoryPrunesPerBlock = 1000
+
+// historyTimeKey builds the LockHistoryByTime key of an entry
+func historyTimeKey(addr sdk.AccAddress, entry types.LockHistoryEntry) collections.Triple[time.Time, sdk.AccAddress, uint64] {
+	return collections.Join3(entry.BlockTime, addr, entry.Id)
+}
+
+// SetLockHistoryEntry stores a lock history entry of an address and indexes it
+// by block time
+func (k Keeper) SetLockHistoryEntry(ctx sdk.Context, addr sdk.AccAddress, entry types.LockHistoryEntry) error {
+	if err := k.LockHistoryEntries.Set(ctx, collections.Join(addr, entry.Id), entry); err != nil {
+		return err
+	}
+
+	return k.LockHistoryByTime.Set(ctx, historyTimeKey(addr, entry))
+}
+
+// recordLockHistory stores entry as a change to the locks of addr made in the
+// current block, if the lock history is enabled. The caller fills in the
+// change, unlock dates, amount and counterparty.
+func (k Keeper) recordLockHistory(ctx sdk.Context, addr sdk.AccAddress, entry types.LockHistoryEntry) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.LockHistoryEnabled {
+		return nil
+	}
+
+	entry.Id, err = k.LockHistorySequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	entry.Address = addr.String()
+	entry.BlockHeight = ctx.BlockHeight()
+	entry.BlockTime = ctx.BlockTime().UTC()
+	if txBytes := ctx.TxBytes(); len(txBytes) != 0 {
+		entry.TxHash = fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+	}
+
+	return k.SetLockHistoryEntry(ctx, addr, entry)
+}
+
+// pruneLockHistory deletes the oldest lock history entries older than the
+// retention window, up to MaxLockHistoryPrunesPerBlock
+func (k Keeper) pruneLockHistory(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.LockHistoryRetentionDays == 0 {
+		return nil
+	}
+
+	cutoff := ctx.BlockTime().AddDate(0, 0, -int(params.LockHistoryRetentionDays)) //nolint:gosec // G115
+
+	// Collect first: the store must not be written while it is iterated.
+	var expired []collections.Triple[time.Time, sdk.AccAddress, uint64]
+	err = k.LockHistoryByTime.Walk(ctx, nil, func(key collections.Triple[time.Time, sdk.AccAddress, uint64]) (bool, error) {
+		if !key.K1().Before(cutoff) {
+			return true, nil
+		}
+
+		expired = append(expired, key)
+		return len(expired) == MaxLockHistoryPrunesPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.LockHistoryEntries.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+
+		if err := k.LockHistoryByTime.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
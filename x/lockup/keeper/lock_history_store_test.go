@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLockHistory(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+	setDelegations(t, f, valAddr, map[string]int64{addr.String(): 1000})
+	f.accountkeeper.SetAccount(f.ctx, f.accountkeeper.NewAccountWithAddress(f.ctx, addr))
+
+	ctx := f.ctx.WithBlockHeight(10).WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	// nothing is recorded while the history is disabled
+	_, err := f.msgServer.Lock(ctx, types.NewMsgLock(addr.String(), "2026-03-01", sdk.NewInt64Coin(bondDenom, 100)))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.LockHistoryEnabled = true
+	params.LockHistoryRetentionDays = 60
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	txBytes := []byte("lock tx")
+	_, err = f.msgServer.Lock(ctx.WithTxBytes(txBytes), types.NewMsgLock(addr.String(), "2026-02-01", sdk.NewInt64Coin(bondDenom, 600)))
+	require.NoError(t, err)
+
+	_, err = f.msgServer.Extend(ctx, &types.MsgExtend{
+		Address: addr.String(),
+		Extensions: []*types.Extension{
+			{FromDate: "2026-02-01", ToDate: "2026-03-01", Amount: sdk.NewInt64Coin(bondDenom, 200)},
+		},
+	})
+	require.NoError(t, err)
+
+	expiryCtx := ctx.WithBlockHeight(20).WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(expiryCtx))
+
+	res, err := f.queryServer.LockHistory(expiryCtx, &types.QueryLockHistoryRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, []types.LockHistoryEntry{
+		{
+			Id:          0,
+			Address:     addr.String(),
+			Change:      types.LOCK_HISTORY_TYPE_CREATED,
+			UnlockDate:  "2026-02-01",
+			Amount:      math.NewInt(600),
+			BlockHeight: 10,
+			BlockTime:   ctx.BlockTime(),
+			TxHash:      fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+		},
+		{
+			Id:            1,
+			Address:       addr.String(),
+			Change:        types.LOCK_HISTORY_TYPE_EXTENDED,
+			UnlockDate:    "2026-03-01",
+			OldUnlockDate: "2026-02-01",
+			Amount:        math.NewInt(200),
+			BlockHeight:   10,
+			BlockTime:     ctx.BlockTime(),
+		},
+		{
+			Id:          2,
+			Address:     addr.String(),
+			Change:      types.LOCK_HISTORY_TYPE_EXPIRED,
+			UnlockDate:  "2026-02-01",
+			Amount:      math.NewInt(400),
+			BlockHeight: 20,
+			BlockTime:   expiryCtx.BlockTime(),
+		},
+	}, res.Entries)
+
+	res, err = f.queryServer.LockHistory(expiryCtx, &types.QueryLockHistoryRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, uint64(1), res.Entries[0].Id)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// the history survives a genesis round trip
+	genState := f.k.ExportGenesis(expiryCtx)
+	require.Len(t, genState.LockHistory, 3)
+	require.Equal(t, uint64(3), genState.NextHistoryId)
+	require.NoError(t, genState.Validate())
+
+	// entries older than the retention window are pruned
+	pruneCtx := ctx.WithBlockTime(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(pruneCtx))
+
+	res, err = f.queryServer.LockHistory(pruneCtx, &types.QueryLockHistoryRequest{Address: addr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, uint64(2), res.Entries[0].Id)
+	require.Equal(t, types.LOCK_HISTORY_TYPE_EXPIRED, res.Entries[1].Change)
+	require.Equal(t, math.NewInt(300), res.Entries[1].Amount)
+}
+
+func TestLockHistoryPruneLimit(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+
+	params := types.DefaultParams()
+	params.LockHistoryRetentionDays = 30
+	require.NoError(t, f.k.Params.Set(f.ctx, params))
+
+	// two entries more than a block prunes, older than the retention window
+	old := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for id := uint64(0); id < keeper.MaxLockHistoryPrunesPerBlock+2; id++ {
+		require.NoError(t, f.k.SetLockHistoryEntry(f.ctx, addr, types.LockHistoryEntry{
+			Id:        id,
+			Address:   addr.String(),
+			Change:    types.LOCK_HISTORY_TYPE_CREATED,
+			BlockTime: old.Add(time.Duration(id) * time.Second),
+		}))
+	}
+
+	// and one within it
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	recent := uint64(keeper.MaxLockHistoryPrunesPerBlock + 2)
+	require.NoError(t, f.k.SetLockHistoryEntry(ctx, addr, types.LockHistoryEntry{
+		Id:        recent,
+		Address:   addr.String(),
+		Change:    types.LOCK_HISTORY_TYPE_CREATED,
+		BlockTime: time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+	}))
+
+	historyIDs := func() []uint64 {
+		res, err := f.queryServer.LockHistory(ctx, &types.QueryLockHistoryRequest{
+			Address:    addr.String(),
+			Pagination: &query.PageRequest{Limit: 10},
+		})
+		require.NoError(t, err)
+
+		var ids []uint64
+		for _, entry := range res.Entries {
+			ids = append(ids, entry.Id)
+		}
+		return ids
+	}
+
+	// the oldest entries are pruned first, the rest in the next block
+	require.NoError(t, f.k.EndBlocker(ctx))
+	require.Equal(t, []uint64{keeper.MaxLockHistoryPrunesPerBlock, keeper.MaxLockHistoryPrunesPerBlock + 1, recent}, historyIDs())
+
+	require.NoError(t, f.k.EndBlocker(ctx))
+	require.Equal(t, []uint64{recent}, historyIDs())
+
+	has, err := f.k.LockHistoryByTime.Has(ctx, collections.Join3(old, addr, uint64(0)))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,393 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// The amount of all lock schedules is kept in ScheduledLocked, as locked at
+// ScheduledLockedTime, the block time the schedule releases were last
+// processed. LockSchedulesByRelease indexes each schedule by its first release
+// after that time, so the EndBlocker and the total only visit the schedules
+// that released a step since.
+
+// scheduleReleaseKey builds the LockSchedulesByRelease key of a schedule,
+// given the time the schedule releases were last processed. Schedules with
+// every step released are indexed at that time to be removed next.
+func scheduleReleaseKey(addr sdk.AccAddress, schedule types.LockSchedule, accountedAt time.Time) collections.Triple[time.Time, sdk.AccAddress, uint64] {
+	releaseTime, found := schedule.Schedule.NextReleaseTime(accountedAt)
+	if !found {
+		releaseTime = accountedAt
+	}
+
+	return collections.Join3(releaseTime, addr, schedule.Id)
+}
+
+// getScheduledLockedTime returns the block time the schedule releases were
+// last processed
+func (k Keeper) getScheduledLockedTime(ctx sdk.Context) (time.Time, error) {
+	accountedAt, err := k.ScheduledLockedTime.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return ctx.BlockTime(), nil
+	}
+
+	return accountedAt, err
+}
+
+// addToScheduledLocked adjusts the scheduled locked total by delta, which may
+// be negative
+func (k Keeper) addToScheduledLocked(ctx sdk.Context, delta math.Int) error {
+	total, err := k.ScheduledLocked.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+
+	total = total.Add(delta)
+	if total.IsNegative() {
+		return types.ErrInvalidAmount.Wrapf("total scheduled locked cannot be negative: %s", total.String())
+	}
+
+	return k.ScheduledLocked.Set(ctx, total)
+}
+
+// SetLockSchedule stores a lock schedule of an address, indexes it by the
+// release time of its next step and updates the scheduled locked total
+func (k Keeper) SetLockSchedule(ctx sdk.Context, addr sdk.AccAddress, schedule types.LockSchedule) error {
+	accountedAt, err := k.getScheduledLockedTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	delta := schedule.LockedAt(accountedAt)
+	previous, err := k.LockSchedules.Get(ctx, collections.Join(addr, schedule.Id))
+	switch {
+	case err == nil:
+		delta = delta.Sub(previous.LockedAt(accountedAt))
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.LockSchedules.Set(ctx, collections.Join(addr, schedule.Id), schedule); err != nil {
+		return err
+	}
+
+	if err := k.LockSchedulesByRelease.Set(ctx, scheduleReleaseKey(addr, schedule, accountedAt)); err != nil {
+		return err
+	}
+
+	return k.addToScheduledLocked(ctx, delta)
+}
+
+// RemoveLockSchedule removes a lock schedule of an address and its index
+// entry, and takes it off the scheduled locked total
+func (k Keeper) RemoveLockSchedule(ctx sdk.Context, addr sdk.AccAddress, schedule types.LockSchedule) error {
+	accountedAt, err := k.getScheduledLockedTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.LockSchedules.Remove(ctx, collections.Join(addr, schedule.Id)); err != nil {
+		return err
+	}
+
+	if err := k.LockSchedulesByRelease.Remove(ctx, scheduleReleaseKey(addr, schedule, accountedAt)); err != nil {
+		return err
+	}
+
+	return k.addToScheduledLocked(ctx, schedule.LockedAt(accountedAt).Neg())
+}
+
+// RecomputeScheduledLocked rebuilds the release index and the scheduled
+// locked total from the stored lock schedules at the current block time.
+// Genesis and the store migrations that introduce the index run it.
+func (k Keeper) RecomputeScheduledLocked(ctx sdk.Context) error {
+	if err := k.LockSchedulesByRelease.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	iter, err := k.LockSchedules.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	schedules, err := iter.KeyValues()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	total := math.ZeroInt()
+	for _, kv := range schedules {
+		total = total.Add(kv.Value.LockedAt(blockTime))
+		if err := k.LockSchedulesByRelease.Set(ctx, scheduleReleaseKey(kv.Key.K1(), kv.Value, blockTime)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ScheduledLocked.Set(ctx, total); err != nil {
+		return err
+	}
+
+	return k.ScheduledLockedTime.Set(ctx, blockTime)
+}
+
+// walkDueSchedules calls fn for every lock schedule that released a step, or
+// has nothing left to release, between the last processing and the current
+// block time
+func (k Keeper) walkDueSchedules(ctx sdk.Context, fn func(key collections.Triple[time.Time, sdk.AccAddress, uint64], schedule types.LockSchedule) error) error {
+	blockTime := ctx.BlockTime()
+	rng := new(collections.Range[collections.Triple[time.Time, sdk.AccAddress, uint64]]).
+		EndExclusive(collections.Join3(blockTime.Add(time.Nanosecond), sdk.AccAddress(nil), uint64(0)))
+
+	// Collect first: the store must not be written while it is iterated.
+	iter, err := k.LockSchedulesByRelease.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	iter.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		schedule, err := k.LockSchedules.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+
+		if err := fn(key, schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkSchedulesReleasingBefore calls fn for every lock schedule that may
+// release a step after the current block time and before end (exclusive), in
+// the order of their next release. A zero end walks every schedule
+// (read-only).
+func (k Keeper) walkSchedulesReleasingBefore(ctx sdk.Context, end time.Time, fn func(schedule types.LockSchedule) error) error {
+	var rng collections.Ranger[collections.Triple[time.Time, sdk.AccAddress, uint64]]
+	if !end.IsZero() {
+		rng = new(collections.Range[collections.Triple[time.Time, sdk.AccAddress, uint64]]).
+			EndExclusive(collections.Join3(end, sdk.AccAddress(nil), uint64(0)))
+	}
+
+	return k.LockSchedulesByRelease.Walk(ctx, rng, func(key collections.Triple[time.Time, sdk.AccAddress, uint64]) (bool, error) {
+		schedule, err := k.LockSchedules.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return true, err
+		}
+
+		return false, fn(schedule)
+	})
+}
+
+// GetLockSchedules retrieves all lock schedules of an address, ordered by id
+func (k Keeper) GetLockSchedules(ctx sdk.Context, addr sdk.AccAddress) ([]types.LockSchedule, error) {
+	iter, err := k.LockSchedules.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	return iter.Values()
+}
+
+// checkMaxLockSchedules returns an error if the address already holds the
+// maximum number of lock schedules allowed by the params
+func (k Keeper) checkMaxLockSchedules(ctx sdk.Context, addr sdk.AccAddress, params types.Params) error {
+	iter, err := k.LockSchedules.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	schedules := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		schedules++
+	}
+
+	if schedules >= params.MaxLockSchedules {
+		return types.ErrTooManyLockSchedules.Wrapf("address %s already has %d lock schedules, maximum is %d", addr, schedules, params.MaxLockSchedules)
+	}
+
+	return nil
+}
+
+// getScheduledLockedAmount returns the amount of the lock schedules of an
+// address still locked at the current block time
+func (k Keeper) getScheduledLockedAmount(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	schedules, err := k.GetLockSchedules(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	locked := math.ZeroInt()
+	for _, schedule := range schedules {
+		locked = locked.Add(schedule.LockedAt(blockTime))
+	}
+
+	return locked, nil
+}
+
+// GetTotalScheduledLocked returns the amount of all lock schedules still
+// locked at the current block time. Only the schedules that released a step
+// since the last processing are read.
+func (k Keeper) GetTotalScheduledLocked(ctx sdk.Context) (math.Int, error) {
+	total, err := k.ScheduledLocked.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return math.Int{}, err
+	}
+
+	accountedAt, err := k.getScheduledLockedTime(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	blockTime := ctx.BlockTime()
+	err = k.walkDueSchedules(ctx, func(_ collections.Triple[time.Time, sdk.AccAddress, uint64], schedule types.LockSchedule) error {
+		total = total.Sub(schedule.LockedAt(accountedAt)).Add(schedule.LockedAt(blockTime))
+		return nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return total, nil
+}
+
+// createLockSchedule stores a new lock schedule for an address and returns
+// its id. The caller is responsible for the checks on the schedule.
+func (k Keeper) createLockSchedule(ctx sdk.Context, addr sdk.AccAddress, amount math.Int, releaseSchedule types.ReleaseSchedule) (uint64, error) {
+	id, err := k.LockScheduleSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	schedule := types.LockSchedule{
+		Id:       id,
+		Address:  addr.String(),
+		Schedule: releaseSchedule,
+		Amount:   amount,
+		Slashed:  math.ZeroInt(),
+	}
+
+	if err := k.SetLockSchedule(ctx, addr, schedule); err != nil {
+		return 0, err
+	}
+
+	endTime := releaseSchedule.EndTime()
+	if err := k.LockupHooks().AfterLockCreated(ctx, addr, endTime, amount); err != nil {
+		return 0, err
+	}
+
+	if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+		Change:     types.LOCK_HISTORY_TYPE_CREATED,
+		UnlockDate: types.FormatUnlockTime(endTime),
+		Amount:     amount,
+	}); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeLockSchedule,
+		sdk.NewAttribute(types.AttributeKeyLockAddress, schedule.Address),
+		sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCliffDate, releaseSchedule.CliffDate),
+		sdk.NewAttribute(types.AttributeKeyReleaseType, releaseSchedule.ReleaseType.String()),
+		sdk.NewAttribute(types.AttributeKeyPeriods, strconv.FormatUint(uint64(releaseSchedule.Periods), 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+
+	return id, nil
+}
+
+// processScheduleReleases takes the steps released since the last
+// processing off the scheduled locked total, reports them to the hooks and
+// the lock history as expired parts of the schedule, reindexes the schedules by their
+// next release and deletes the schedules with nothing left locked, emitting a
+// lock_schedule_released event for each of them
+func (k Keeper) processScheduleReleases(ctx sdk.Context) error {
+	accountedAt, err := k.getScheduledLockedTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+
+	released := math.ZeroInt()
+	events := sdk.Events{}
+	err = k.walkDueSchedules(ctx, func(key collections.Triple[time.Time, sdk.AccAddress, uint64], schedule types.LockSchedule) error {
+		addr := key.K2()
+		stepped := schedule.LockedAt(accountedAt).Sub(schedule.LockedAt(blockTime))
+		released = released.Add(stepped)
+
+		if stepped.IsPositive() {
+			endTime := schedule.Schedule.EndTime()
+			if err := k.LockupHooks().AfterLockExpired(ctx, addr, endTime, stepped); err != nil {
+				return err
+			}
+
+			if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+				Change:     types.LOCK_HISTORY_TYPE_EXPIRED,
+				UnlockDate: types.FormatUnlockTime(endTime),
+				Amount:     stepped,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if err := k.LockSchedulesByRelease.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if releaseTime, found := schedule.Schedule.NextReleaseTime(blockTime); found && schedule.LockedAt(blockTime).IsPositive() {
+			return k.LockSchedulesByRelease.Set(ctx, collections.Join3(releaseTime, addr, schedule.Id))
+		}
+
+		if err := k.LockSchedules.Remove(ctx, collections.Join(addr, schedule.Id)); err != nil {
+			return err
+		}
+
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeLockScheduleReleased,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, schedule.Address),
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, schedule.Amount.Sub(schedule.Slashed).String()),
+		))
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.addToScheduledLocked(ctx, released.Neg()); err != nil {
+		return err
+	}
+
+	if err := k.ScheduledLockedTime.Set(ctx, blockTime); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
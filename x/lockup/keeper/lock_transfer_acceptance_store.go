@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Lock transfer acceptances
+//
+// A lock transfer hands the recipient locked, delegated tokens it cannot move
+// until the unlock date, so MsgTransferLock only transfers to an address that
+// accepts the lock transfers of the sender. The acceptance stays until the
+// recipient refuses the transfers of the sender again.
+
+// SetLockTransferAcceptance makes addr accept, or refuse again, the lock
+// transfers of fromAddr
+func (k Keeper) SetLockTransferAcceptance(ctx context.Context, addr, fromAddr sdk.AccAddress, accept bool) error {
+	if !accept {
+		return k.LockTransferAcceptances.Remove(ctx, collections.Join(addr, fromAddr))
+	}
+
+	return k.LockTransferAcceptances.Set(ctx, collections.Join(addr, fromAddr))
+}
+
+// AcceptsLockTransfers reports whether addr accepts the lock transfers of
+// fromAddr
+func (k Keeper) AcceptsLockTransfers(ctx context.Context, addr, fromAddr sdk.AccAddress) (bool, error) {
+	return k.LockTransferAcceptances.Has(ctx, collections.Join(addr, fromAddr))
+}
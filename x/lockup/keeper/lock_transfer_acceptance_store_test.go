@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLockTransferAcceptances(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	fromAddr := f.addrs[1]
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+
+	accepted := func(addr, fromAddr sdk.AccAddress) bool {
+		res, err := f.queryServer.LockTransferAcceptance(f.ctx, &types.QueryLockTransferAcceptanceRequest{Address: addr.String(), FromAddress: fromAddr.String()})
+		require.NoError(t, err)
+		return res.Accepted
+	}
+
+	require.False(t, accepted(addr, fromAddr))
+
+	ctx := f.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := f.msgServer.AcceptLockTransfers(ctx, types.NewMsgAcceptLockTransfers(addr.String(), fromAddr.String(), true))
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeLockTransferAcceptance,
+		sdk.NewAttribute(types.AttributeKeyLockAddress, addr.String()),
+		sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyAccepted, "true"),
+	)}, ctx.EventManager().Events())
+
+	// the acceptance only goes one way
+	require.True(t, accepted(addr, fromAddr))
+	require.False(t, accepted(fromAddr, addr))
+
+	// an address cannot accept its own transfers
+	_, err = f.msgServer.AcceptLockTransfers(f.ctx, types.NewMsgAcceptLockTransfers(addr.String(), addr.String(), true))
+	require.Error(t, err)
+
+	// the acceptance survives a genesis round trip
+	genState := f.k.ExportGenesis(f.ctx)
+	acceptance := types.LockTransferAcceptance{Address: addr.String(), FromAddress: fromAddr.String()}
+	require.Equal(t, []types.LockTransferAcceptance{acceptance}, genState.LockTransferAcceptances)
+	require.NoError(t, genState.Validate())
+
+	genState.LockTransferAcceptances = append(genState.LockTransferAcceptances, acceptance)
+	require.Error(t, genState.Validate())
+
+	_, err = f.msgServer.AcceptLockTransfers(f.ctx, types.NewMsgAcceptLockTransfers(addr.String(), fromAddr.String(), false))
+	require.NoError(t, err)
+	require.False(t, accepted(addr, fromAddr))
+}
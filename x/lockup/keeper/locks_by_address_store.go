@@ -3,163 +3,231 @@ package keeper
 import (
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// GetLocksByAddressKey creates the key for storing locks by address
-// Key: LocksByAddressKey + Address
-func (k Keeper) GetLocksByAddressKey(addr sdk.AccAddress) []byte {
-	return append(types.LocksByAddressKey, addr.Bytes()...)
+// lockKey builds the LocksByAddress key for an address and unlock date
+func lockKey(addr sdk.AccAddress, unlockDate string) (collections.Pair[sdk.AccAddress, time.Time], error) {
+	unlockTime, err := types.ParseUnlockTime(unlockDate)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, time.Time]{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", unlockDate)
+	}
+
+	return collections.Join(addr, unlockTime), nil
 }
 
+// newLock builds a lock record from its LocksByAddress key and amount
+func newLock(key collections.Pair[sdk.AccAddress, time.Time], amount math.Int) *types.Lock {
+	return &types.Lock{
+		UnlockDate: types.FormatUnlockTime(key.K2()),
+		Amount:     amount,
+	}
+}
+
+// activeLocksRange returns the range over the locks of an address that are
+// still locked at the current block time
+func activeLocksRange(ctx sdk.Context, addr sdk.AccAddress) *collections.PairRange[sdk.AccAddress, time.Time] {
+	blockTime := ctx.BlockTime()
+
+	return collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](addr).StartExclusive(blockTime)
+}
+
+// GetLockedAmountByAddress returns the amount an address has locked at the
+// current block time, including the unreleased part of its lock schedules
 func (k Keeper) GetLockedAmountByAddress(ctx sdk.Context, addr sdk.AccAddress) (*math.Int, error) {
-	locks, err := k.GetLocksByAddress(ctx, addr)
+	iter, err := k.LocksByAddress.Iterate(ctx, activeLocksRange(ctx, addr))
 	if err != nil {
 		return nil, err
 	}
-
-	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+	defer iter.Close()
 
 	totalLocked := math.ZeroInt()
-	for _, lock := range locks {
-		if types.IsLocked(blockDay, lock.UnlockDate) {
-			totalLocked = totalLocked.Add(lock.Amount)
+	for ; iter.Valid(); iter.Next() {
+		amount, err := iter.Value()
+		if err != nil {
+			return nil, err
 		}
+		totalLocked = totalLocked.Add(amount)
+	}
+
+	scheduled, err := k.getScheduledLockedAmount(ctx, addr)
+	if err != nil {
+		return nil, err
 	}
+	totalLocked = totalLocked.Add(scheduled)
 
 	return &totalLocked, nil
 }
 
-// SetLockByAddress adds a lock to an address key
-func (k Keeper) SetLockByAddress(ctx sdk.Context, addr sdk.AccAddress, lock *types.Lock) error {
-	store := k.storeService.OpenKVStore(ctx)
-	key := k.GetLocksByAddressKey(addr)
+// checkMaxUnlockDates returns an error if the address already holds the
+// maximum number of distinct active unlock dates allowed by the params, i.e.
+// if adding a lock on a new date would exceed the limit.
+func (k Keeper) checkMaxUnlockDates(ctx sdk.Context, addr sdk.AccAddress, params types.Params) error {
+	if params.MaxUnlockDates == 0 {
+		return nil
+	}
 
-	locksList := &types.Locks{}
-	bz, err := store.Get(key)
+	iter, err := k.LocksByAddress.Iterate(ctx, activeLocksRange(ctx, addr))
 	if err != nil {
 		return err
 	}
+	defer iter.Close()
 
-	if bz != nil {
-		if err := locksList.Unmarshal(bz); err != nil {
-			return err
-		}
+	activeDates := uint64(0)
+	for ; iter.Valid(); iter.Next() {
+		activeDates++
 	}
 
-	// before appending insert the lock into the correct position based on unlock date
-	inserted := false
-	for i, existingLock := range locksList.Locks {
-		if lock.UnlockDate < existingLock.UnlockDate {
-			locksList.Locks = append(locksList.Locks[:i], append([]*types.Lock{lock}, locksList.Locks[i:]...)...)
-			inserted = true
-			break
-		}
-	}
-	if !inserted {
-		locksList.Locks = append(locksList.Locks, lock)
+	if activeDates >= params.MaxUnlockDates {
+		return types.ErrTooManyUnlockDates.Wrapf("address %s already has %d unlock dates, maximum is %d", addr, activeDates, params.MaxUnlockDates)
 	}
 
-	bz, err = locksList.Marshal()
+	return nil
+}
+
+// SetLockByAddress stores the lock of an address for its unlock date,
+// replacing any amount previously stored for that date. The bindings of the
+// lock are trimmed to the new amount.
+func (k Keeper) SetLockByAddress(ctx sdk.Context, addr sdk.AccAddress, lock *types.Lock) error {
+	key, err := lockKey(addr, lock.UnlockDate)
 	if err != nil {
 		return err
 	}
 
-	return store.Set(key, bz)
-}
+	if _, err := k.trimLockBindings(ctx, addr, key.K2(), lock.Amount, nil); err != nil {
+		return err
+	}
 
-// SetLocksByAddress stores all locks for an address in a single key-value pair
-func (k Keeper) SetLocksByAddress(ctx sdk.Context, addr sdk.AccAddress, locks []*types.Lock) error {
-	store := k.storeService.OpenKVStore(ctx)
-	key := k.GetLocksByAddressKey(addr)
+	return k.LocksByAddress.Set(ctx, key, lock.Amount)
+}
 
-	if len(locks) == 0 {
-		return store.Delete(key)
+// GetLocksByAddress retrieves all locks for an address, ordered by unlock date
+func (k Keeper) GetLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) ([]*types.Lock, error) {
+	iter, err := k.LocksByAddress.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, time.Time](addr))
+	if err != nil {
+		return nil, err
 	}
+	defer iter.Close()
 
-	locksList := types.Locks{Locks: locks}
-	bz, err := locksList.Marshal()
-	if err != nil {
-		return err
+	locks := []*types.Lock{}
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, newLock(kv.Key, kv.Value))
 	}
 
-	return store.Set(key, bz)
+	return locks, nil
 }
 
-// GetLocksByAddress retrieves all locks for an address
-func (k Keeper) GetLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) ([]*types.Lock, error) {
-	store := k.storeService.OpenKVStore(ctx)
-	key := k.GetLocksByAddressKey(addr)
+// GetLockByAddressAndDate retrieves a lock for a specific address and unlock date
+func (k Keeper) GetLockByAddressAndDate(ctx sdk.Context, addr sdk.AccAddress, unlockDate string) (*types.Lock, bool) {
+	key, err := lockKey(addr, unlockDate)
+	if err != nil {
+		return nil, false
+	}
 
-	bz, err := store.Get(key)
+	amount, err := k.LocksByAddress.Get(ctx, key)
 	if err != nil {
-		return nil, err
+		return nil, false
 	}
 
-	if bz == nil {
-		return []*types.Lock{}, nil
+	return newLock(key, amount), true
+}
+
+// RemoveLockByAddressAndDate removes the lock of an address for an unlock date
+// together with its bindings
+func (k Keeper) RemoveLockByAddressAndDate(ctx sdk.Context, addr sdk.AccAddress, unlockDate string) error {
+	key, err := lockKey(addr, unlockDate)
+	if err != nil {
+		return err
 	}
 
-	locksList := &types.Locks{}
-	if err := locksList.Unmarshal(bz); err != nil {
-		return nil, err
+	if _, err := k.trimLockBindings(ctx, addr, key.K2(), math.ZeroInt(), nil); err != nil {
+		return err
 	}
 
-	return locksList.Locks, nil
+	return k.LocksByAddress.Remove(ctx, key)
 }
 
-// GetLockByAddressAndDate retrieves a lock for a specific address and unlock date
-func (k Keeper) GetLockByAddressAndDate(ctx sdk.Context, addr sdk.AccAddress, unlockDate string) (*types.Lock, int, bool) {
+// DeleteLocksByAddress removes all locks for an address together with their
+// bindings
+func (k Keeper) DeleteLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) error {
 	locks, err := k.GetLocksByAddress(ctx, addr)
 	if err != nil {
-		return nil, -1, false
+		return err
 	}
 
-	for idx, lock := range locks {
-		if lock.UnlockDate == unlockDate {
-			return lock, idx, true
+	for _, lock := range locks {
+		if err := k.RemoveLockByAddressAndDate(ctx, addr, lock.UnlockDate); err != nil {
+			return err
 		}
 	}
 
-	return nil, -1, false
-}
-
-// DeleteLocksByAddress removes all locks for an address
-func (k Keeper) DeleteLocksByAddress(ctx sdk.Context, addr sdk.AccAddress) error {
-	store := k.storeService.OpenKVStore(ctx)
-	key := k.GetLocksByAddressKey(addr)
-	return store.Delete(key)
+	return nil
 }
 
-// DeleteLockByAddressAndIndex removes a specific lock for an address by its index
-func (k Keeper) DeleteLockByAddressAndIndex(ctx sdk.Context, addr sdk.AccAddress, index int) error {
-	locks, err := k.GetLocksByAddress(ctx, addr)
+// IterateLocksByAddress iterates over the lock records of every address
+func (k Keeper) IterateLocksByAddress(ctx sdk.Context, cb func(addr sdk.AccAddress, locks []*types.Lock) error) error {
+	iter, err := k.LocksByAddress.Iterate(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer iter.Close()
+
+	var (
+		addr  sdk.AccAddress
+		locks []*types.Lock
+	)
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+
+		// keys are ordered by address, so an address change closes the
+		// previous address's records
+		if addr != nil && !addr.Equals(kv.Key.K1()) {
+			if err := cb(addr, locks); err != nil {
+				return err
+			}
+			locks = nil
+		}
+
+		addr = kv.Key.K1()
+		locks = append(locks, newLock(kv.Key, kv.Value))
+	}
 
-	if index < 0 || index >= len(locks) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("lock doesn't exist at index %d", index)
+	if addr != nil {
+		return cb(addr, locks)
 	}
 
-	locks = append(locks[:index], locks[index+1:]...)
-	return k.SetLocksByAddress(ctx, addr, locks)
+	return nil
 }
 
-func (k Keeper) UpdateLockByAddressAndIndex(ctx sdk.Context, addr sdk.AccAddress, index int, lock *types.Lock) error {
-	locks, err := k.GetLocksByAddress(ctx, addr)
+// iterateLockers calls cb once for every address holding locks or lock
+// schedules, addresses with locks first
+func (k Keeper) iterateLockers(ctx sdk.Context, cb func(addr sdk.AccAddress) error) error {
+	seen := make(map[string]struct{})
+	err := k.IterateLocksByAddress(ctx, func(addr sdk.AccAddress, _ []*types.Lock) error {
+		seen[string(addr)] = struct{}{}
+		return cb(addr)
+	})
 	if err != nil {
 		return err
 	}
 
-	if index < 0 || index >= len(locks) {
-		return sdkerrors.ErrInvalidRequest.Wrapf("lock doesn't exist at index %d", index)
-	}
+	return k.LockSchedules.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], _ types.LockSchedule) (bool, error) {
+		if _, found := seen[string(key.K1())]; found {
+			return false, nil
+		}
+		seen[string(key.K1())] = struct{}{}
 
-	locks[index] = lock
-	return k.SetLocksByAddress(ctx, addr, locks)
+		return false, cb(key.K1())
+	})
 }
@@ -28,6 +28,47 @@ func (k Keeper) GetTotalLocked(ctx context.Context) (math.Int, error) {
 	return amount, nil
 }
 
+// SetTotalLocked stores the running total of the amounts in the expiration queue
+func (k Keeper) SetTotalLocked(ctx context.Context, amount math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	return store.Set(types.TotalLockedKey, bz)
+}
+
+// RecomputeTotalLocked sets the running total to the sum of the expiration
+// queue. Chains whose queue predates the aggregate must run it once, in a
+// store migration, before any lock is removed from the queue.
+func (k Keeper) RecomputeTotalLocked(ctx context.Context) error {
+	total := math.ZeroInt()
+	err := k.IterateExpirationQueue(ctx, func(_ sdk.AccAddress, _ time.Time, amount math.Int) error {
+		total = total.Add(amount)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.SetTotalLocked(ctx, total)
+}
+
+// addToTotalLocked adjusts the running total by delta, which may be negative
+func (k Keeper) addToTotalLocked(ctx context.Context, delta math.Int) error {
+	total, err := k.GetTotalLocked(ctx)
+	if err != nil {
+		return err
+	}
+
+	total = total.Add(delta)
+	if total.IsNegative() {
+		return types.ErrInvalidAmount.Wrapf("total locked cannot be negative: %s", total.String())
+	}
+
+	return k.SetTotalLocked(ctx, total)
+}
+
 // GetLockExpirationKey creates the key for the lock expiration queue
 // Key: Prefix + Timestamp (8 bytes) + Address
 func (k Keeper) GetLockExpirationKey(unlockTime time.Time, addr sdk.AccAddress) []byte {
@@ -60,7 +101,11 @@ func (k Keeper) AddToExpirationQueue(ctx context.Context, unlockTime time.Time,
 		return err
 	}
 
-	return store.Set(key, bz)
+	if err := store.Set(key, bz); err != nil {
+		return err
+	}
+
+	return k.addToTotalLocked(ctx, amount)
 }
 
 // RemoveFromExpirationQueue removes an amount from the expiration queue
@@ -87,6 +132,10 @@ func (k Keeper) RemoveFromExpirationQueue(ctx context.Context, unlockTime time.T
 		return types.ErrInvalidAmount.Wrapf("cannot remove %s from expiration queue, only %s available", amount.String(), currentAmount.String())
 	}
 
+	if err := k.addToTotalLocked(ctx, amount.Neg()); err != nil {
+		return err
+	}
+
 	newAmount := currentAmount.Sub(amount)
 
 	if newAmount.IsZero() {
@@ -101,43 +150,55 @@ func (k Keeper) RemoveFromExpirationQueue(ctx context.Context, unlockTime time.T
 	return store.Set(key, bz)
 }
 
-// IterateActiveLocks iterates over all locks that have NOT expired yet (read-only)
-func (k Keeper) IterateActiveLocks(ctx context.Context, currentTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
-	store := k.storeService.OpenKVStore(ctx)
+// expirationQueueTimeKey returns the first expiration queue key of the locks
+// unlocking at a Unix second
+func expirationQueueTimeKey(unix int64) []byte {
+	key := make([]byte, len(types.LocksByDateKey)+8)
+	copy(key, types.LocksByDateKey)
+	binary.BigEndian.PutUint64(key[len(types.LocksByDateKey):], uint64(unix)) //nolint:gosec // G115
+	return key
+}
+
+// decodeExpirationQueueEntry decodes an expiration queue key and value.
+// ok is false for a key too short to hold an unlock time.
+func decodeExpirationQueueEntry(key, value []byte) (addr sdk.AccAddress, unlockTime time.Time, amount math.Int, ok bool, err error) {
+	// Prefix (len) + Time (8) + Addr (Remainder)
+	prefixLen := len(types.LocksByDateKey)
+	if len(key) < prefixLen+8 {
+		return nil, time.Time{}, math.Int{}, false, nil
+	}
+
+	unlockUnix := binary.BigEndian.Uint64(key[prefixLen : prefixLen+8])
+	unlockTime = time.Unix(int64(unlockUnix), 0) //nolint:gosec // G115
+	addr = sdk.AccAddress(key[prefixLen+8:])
+
+	if err := amount.Unmarshal(value); err != nil {
+		return nil, time.Time{}, math.Int{}, false, err
+	}
 
-	// Start key is Prefix + CurrentTime + 1 second (to exclude locks expiring at or before current time)
-	startTimeBz := make([]byte, 8)
-	binary.BigEndian.PutUint64(startTimeBz, uint64(currentTime.Unix()+1))
-	startKey := append(types.LocksByDateKey, startTimeBz...)
+	return addr, unlockTime, amount, true, nil
+}
 
-	// End key is nil to iterate to the end
-	iter, err := store.Iterator(startKey, nil)
+// iterateExpirationQueueRange iterates over the expiration queue entries from
+// the start key (inclusive) to the end key (exclusive), in time order
+// (read-only)
+func (k Keeper) iterateExpirationQueueRange(ctx context.Context, startKey, endKey []byte, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iter, err := store.Iterator(startKey, endKey)
 	if err != nil {
 		return err
 	}
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		// Parse Key
-		// Prefix (len) + Time (8) + Addr (Remainder)
-		prefixLen := len(types.LocksByDateKey)
-
-		if len(key) < prefixLen+8 {
-			continue
-		}
-
-		timeBz := key[prefixLen : prefixLen+8]
-		addrBz := key[prefixLen+8:]
-
-		unlockUnix := binary.BigEndian.Uint64(timeBz)
-		unlockTime := time.Unix(int64(unlockUnix), 0)
-		addr := sdk.AccAddress(addrBz)
-
-		var amount math.Int
-		if err := amount.Unmarshal(iter.Value()); err != nil {
+		addr, unlockTime, amount, ok, err := decodeExpirationQueueEntry(iter.Key(), iter.Value())
+		if err != nil {
 			return err
 		}
+		if !ok {
+			continue
+		}
 
 		if err := cb(addr, unlockTime, amount); err != nil {
 			return err
@@ -147,37 +208,74 @@ func (k Keeper) IterateActiveLocks(ctx context.Context, currentTime time.Time, c
 	return nil
 }
 
+// IterateExpirationQueue iterates over every entry of the expiration queue,
+// expired or not (read-only)
+func (k Keeper) IterateExpirationQueue(ctx context.Context, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	return k.iterateExpirationQueueRange(ctx, types.LocksByDateKey, prefixEndBytes(types.LocksByDateKey), cb)
+}
+
+// IterateActiveLocks iterates over all locks that have NOT expired yet (read-only)
+func (k Keeper) IterateActiveLocks(ctx context.Context, currentTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	// Start at CurrentTime + 1 second to exclude locks expiring at or before
+	// the current time; end at the end of the prefix, so no other store keys
+	// are visited.
+	return k.iterateExpirationQueueRange(ctx, expirationQueueTimeKey(currentTime.Unix()+1), prefixEndBytes(types.LocksByDateKey), cb)
+}
+
+// IterateExpirationQueueBetween iterates over the expiration queue entries
+// unlocking from start (inclusive) to end (exclusive), in time order. A zero
+// end iterates to the end of the queue (read-only).
+func (k Keeper) IterateExpirationQueueBetween(ctx context.Context, start, end time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
+	// Unlock times are stored in whole seconds.
+	startUnix := start.Unix()
+	if start.Nanosecond() != 0 {
+		startUnix++
+	}
+
+	endKey := prefixEndBytes(types.LocksByDateKey)
+	if !end.IsZero() {
+		endUnix := end.Unix()
+		if end.Nanosecond() != 0 {
+			endUnix++
+		}
+		endKey = expirationQueueTimeKey(endUnix)
+	}
+
+	return k.iterateExpirationQueueRange(ctx, expirationQueueTimeKey(startUnix), endKey, cb)
+}
+
 // IterateAndDeleteExpiredLocks iterates over locks that have expired before or at cutoffTime and deletes them
 func (k Keeper) IterateAndDeleteExpiredLocks(ctx context.Context, cutoffTime time.Time, cb func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error) error {
 	store := k.storeService.OpenKVStore(ctx)
 
 	// End key is Prefix + CutoffTime + 1 second (to include CutoffTime)
-	endTimeBz := make([]byte, 8)
-	binary.BigEndian.PutUint64(endTimeBz, uint64(cutoffTime.Unix()+1))
-	endKey := append(types.LocksByDateKey, endTimeBz...)
+	endKey := expirationQueueTimeKey(cutoffTime.Unix() + 1)
 
 	iter, err := store.Iterator(types.LocksByDateKey, endKey)
 	if err != nil {
 		return err
 	}
-	defer iter.Close()
 
+	// Collect the expired keys first: the store must not be written to while
+	// the iterator is open.
+	var expiredKeys, expiredValues [][]byte
 	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		// Parse Key
-		// Prefix (len) + Time (8) + Addr (Remainder)
-		prefixLen := len(types.LocksByDateKey)
-		timeBz := key[prefixLen : prefixLen+8]
-		addrBz := key[prefixLen+8:]
-
-		unlockUnix := binary.BigEndian.Uint64(timeBz)
-		unlockTime := time.Unix(int64(unlockUnix), 0)
-		addr := sdk.AccAddress(addrBz)
-
-		var amount math.Int
-		if err := amount.Unmarshal(iter.Value()); err != nil {
+		expiredKeys = append(expiredKeys, iter.Key())
+		expiredValues = append(expiredValues, iter.Value())
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range expiredKeys {
+		addr, unlockTime, amount, ok, err := decodeExpirationQueueEntry(key, expiredValues[i])
+		if err != nil {
 			return err
 		}
+		if !ok {
+			continue
+		}
 
 		if err := cb(addr, unlockTime, amount); err != nil {
 			return err
@@ -186,6 +284,10 @@ func (k Keeper) IterateAndDeleteExpiredLocks(ctx context.Context, cutoffTime tim
 		if err := store.Delete(key); err != nil {
 			return err
 		}
+
+		if err := k.addToTotalLocked(ctx, amount.Neg()); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/TrustedSmartChain/tsc/v2/x/lockup/migrations/v2"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The per-address lock blobs are
+// moved to the keyed LocksByAddress map and the total-locked aggregate, which
+// v1 never wrote, is recomputed from the expiration queue.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.LocksByAddress); err != nil {
+		return err
+	}
+
+	return m.keeper.RecomputeTotalLocked(ctx)
+}
+
+// Migrate2to3 migrates from version 2 to 3. The lock schedules, indexed by the
+// release of their last step in v2, are reindexed by their next release and
+// their locked total is computed. The lock schedule and precompile gas params
+// added in v3 are set to their defaults.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.LockScheduleEnabled = types.DefaultLockScheduleEnabled
+	params.MaxLockSchedules = types.DefaultMaxLockSchedules
+	params.PrecompileGas = types.DefaultPrecompileGas
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	return m.keeper.RecomputeScheduledLocked(ctx)
+}
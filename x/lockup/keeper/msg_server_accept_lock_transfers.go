@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AcceptLockTransfers makes the signer accept, or refuse again, the locks
+// another address transfers to it with MsgTransferLock.
+func (k msgServer) AcceptLockTransfers(goCtx context.Context, msg *types.MsgAcceptLockTransfers) (*types.MsgAcceptLockTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if address.Equals(fromAddress) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot accept lock transfers from the same address")
+	}
+
+	if err := k.SetLockTransferAcceptance(ctx, address, fromAddress, msg.Accept); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLockTransferAcceptance,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyAccepted, strconv.FormatBool(msg.Accept)),
+		),
+	})
+
+	return &types.MsgAcceptLockTransfersResponse{}, nil
+}
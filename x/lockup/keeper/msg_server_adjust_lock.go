@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AdjustLock sets the lock of an address on a given unlock date to the
+// requested amount. An increase must still be covered by the delegations of
+// the address; the lock period and date count limits of the params do not
+// apply.
+func (k msgServer) AdjustLock(goCtx context.Context, msg *types.MsgAdjustLock) (*types.MsgAdjustLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	if msg.Amount.IsNegative() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid lock amount: %s", msg.Amount.String())
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock on %s is already unlocked", msg.UnlockDate)
+	}
+
+	oldAmount := math.ZeroInt()
+	if existingLock, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate); found {
+		oldAmount = existingLock.Amount
+	}
+
+	newAmount := msg.Amount.Amount
+	if newAmount.Equal(oldAmount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock on %s already holds %s", msg.UnlockDate, newAmount)
+	}
+
+	if newAmount.GT(oldAmount) {
+		currentLockedAmount, err := k.GetLockedAmountByAddress(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		totalDelegatedAmount, err := k.GetTotalDelegatedAmount(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		requiredAmount := currentLockedAmount.Add(newAmount.Sub(oldAmount))
+		if totalDelegatedAmount.LT(requiredAmount) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInsufficientDelegations,
+				"insufficient delegated tokens to cover the adjusted locks: %s < %s",
+				totalDelegatedAmount.String(),
+				requiredAmount.String(),
+			)
+		}
+
+		err = k.SetLockByAddress(ctx, address, &types.Lock{UnlockDate: msg.UnlockDate, Amount: newAmount})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.AddToExpirationQueue(ctx, unlockDate, address, newAmount.Sub(oldAmount)); err != nil {
+			return nil, err
+		}
+
+		if err := k.LockupHooks().AfterLockCreated(ctx, address, unlockDate, newAmount.Sub(oldAmount)); err != nil {
+			return nil, err
+		}
+
+		if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+			Change:     types.LOCK_HISTORY_TYPE_CREATED,
+			UnlockDate: types.FormatUnlockTime(unlockDate),
+			Amount:     newAmount.Sub(oldAmount),
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		if newAmount.IsZero() {
+			err = k.RemoveLockByAddressAndDate(ctx, address, msg.UnlockDate)
+		} else {
+			err = k.SetLockByAddress(ctx, address, &types.Lock{UnlockDate: msg.UnlockDate, Amount: newAmount})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.RemoveFromExpirationQueue(ctx, unlockDate, address, oldAmount.Sub(newAmount)); err != nil {
+			return nil, err
+		}
+
+		if err := k.LockupHooks().AfterLockRemoved(ctx, address, unlockDate, oldAmount.Sub(newAmount)); err != nil {
+			return nil, err
+		}
+
+		if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+			Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+			UnlockDate: types.FormatUnlockTime(unlockDate),
+			Amount:     oldAmount.Sub(newAmount),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLockAdjusted,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, types.FormatUnlockTime(unlockDate)),
+			sdk.NewAttribute(types.AttributeKeyOldAmount, oldAmount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, newAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		),
+	})
+
+	return &types.MsgAdjustLockResponse{}, nil
+}
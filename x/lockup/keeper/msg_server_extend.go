@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +22,11 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 		return nil, sdkerrors.ErrNotFound.Wrapf("no account found for address: %s", msg.Address)
 	}
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	events := sdk.Events{}
 	for _, extension := range msg.Extensions {
 
@@ -35,12 +39,12 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", extension.Amount.Denom, bondDenom)
 		}
 
-		fromDate, err := time.Parse(time.DateOnly, extension.FromDate)
+		fromDate, err := types.ParseUnlockTime(extension.FromDate)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid from date format: %s", extension.FromDate)
 		}
 
-		toDate, err := time.Parse(time.DateOnly, extension.ToDate)
+		toDate, err := types.ParseUnlockTime(extension.ToDate)
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid to date format (%s)", extension.ToDate)
 		}
@@ -50,17 +54,16 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 		}
 
 		blockTime := ctx.BlockTime()
-		blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-		if blockDay.After(toDate) || blockDay.Equal(toDate) {
+		if !blockTime.Before(toDate) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date must be in the future")
 		}
 
-		if blockDay.AddDate(2, 0, 0).Before(toDate) {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date cannot be more than 2 years from now")
+		if params.MaxUnlockTime(blockTime).Before(toDate) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("to date cannot be more than %d months from now", params.MaxLockMonths)
 		}
 
-		existingFromLock, idx, found := k.GetLockByAddressAndDate(ctx, addr, extension.FromDate)
+		existingFromLock, found := k.GetLockByAddressAndDate(ctx, addr, extension.FromDate)
 		if !found {
 			return nil, types.ErrLockupNotFound.Wrapf("no lockup found for from date (%s)", extension.FromDate)
 		}
@@ -68,8 +71,16 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 		amountToMove := extension.Amount.Amount
 		if existingFromLock.Amount.LT(amountToMove) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("extension amount exceeds existing lock amount date (%s)", extension.FromDate)
-		} else if existingFromLock.Amount.Equal(amountToMove) {
-			if err := k.DeleteLockByAddressAndIndex(ctx, addr, idx); err != nil {
+		}
+
+		// the bindings the remaining lock no longer covers move with the amount
+		movedBindings, err := k.trimLockBindings(ctx, addr, fromDate, existingFromLock.Amount.Sub(amountToMove), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if existingFromLock.Amount.Equal(amountToMove) {
+			if err := k.RemoveLockByAddressAndDate(ctx, addr, extension.FromDate); err != nil {
 				return nil, err
 			}
 		} else {
@@ -77,20 +88,24 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 				UnlockDate: existingFromLock.UnlockDate,
 				Amount:     existingFromLock.Amount.Sub(amountToMove),
 			}
-			err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, updatedLock)
+			err = k.SetLockByAddress(ctx, addr, updatedLock)
 			if err != nil {
 				return nil, err
 			}
 		}
 
-		existingToLock, idx, found := k.GetLockByAddressAndDate(ctx, addr, extension.ToDate)
+		existingToLock, found := k.GetLockByAddressAndDate(ctx, addr, extension.ToDate)
 		if found {
 			updatedLock := &types.Lock{
 				UnlockDate: existingToLock.UnlockDate,
 				Amount:     existingToLock.Amount.Add(amountToMove),
 			}
-			err = k.UpdateLockByAddressAndIndex(ctx, addr, idx, updatedLock)
+			err = k.SetLockByAddress(ctx, addr, updatedLock)
 		} else {
+			if err := k.checkMaxUnlockDates(ctx, addr, params); err != nil {
+				return nil, err
+			}
+
 			newLock := &types.Lock{
 				UnlockDate: extension.ToDate,
 				Amount:     amountToMove,
@@ -101,6 +116,12 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			return nil, err
 		}
 
+		for _, binding := range movedBindings {
+			if err := k.bindLock(ctx, addr, toDate, binding.valAddr, binding.amount); err != nil {
+				return nil, err
+			}
+		}
+
 		if err := k.RemoveFromExpirationQueue(ctx, fromDate, addr, amountToMove); err != nil {
 			return nil, err
 		}
@@ -109,11 +130,24 @@ func (k msgServer) Extend(goCtx context.Context, msg *types.MsgExtend) (*types.M
 			return nil, err
 		}
 
+		if err := k.LockupHooks().AfterLockExtended(ctx, addr, fromDate, toDate, amountToMove); err != nil {
+			return nil, err
+		}
+
+		if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+			Change:        types.LOCK_HISTORY_TYPE_EXTENDED,
+			UnlockDate:    types.FormatUnlockTime(toDate),
+			OldUnlockDate: types.FormatUnlockTime(fromDate),
+			Amount:        amountToMove,
+		}); err != nil {
+			return nil, err
+		}
+
 		events = events.AppendEvent(sdk.NewEvent(
 			types.EventTypeLockExtended,
 			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyOldUnlockDate, extension.FromDate),
-			sdk.NewAttribute(types.AttributeKeyUnlockDate, extension.ToDate),
+			sdk.NewAttribute(types.AttributeKeyOldUnlockDate, types.FormatUnlockTime(fromDate)),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, types.FormatUnlockTime(toDate)),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amountToMove.String()),
 		))
 	}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ForceUnlock removes the lock of an address on a given unlock date, or all of
+// its locks and lock schedules when no date is given. The tokens stay
+// delegated; only the lock constraint is lifted.
+func (k msgServer) ForceUnlock(goCtx context.Context, msg *types.MsgForceUnlock) (*types.MsgForceUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	var (
+		locks     []*types.Lock
+		schedules []types.LockSchedule
+	)
+	if msg.UnlockDate == "" {
+		locks, err = k.GetLocksByAddress(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		schedules, err = k.GetLockSchedules(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		lock, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+		if found {
+			locks = append(locks, lock)
+		}
+	}
+
+	if len(locks) == 0 && len(schedules) == 0 {
+		return nil, types.ErrLockupNotFound.Wrapf("no lockup found for address %s", msg.Address)
+	}
+
+	released := math.ZeroInt()
+	events := make([]sdk.Event, 0, len(locks)+len(schedules))
+	for _, lock := range locks {
+		unlockDate, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", lock.UnlockDate)
+		}
+
+		if err := k.RemoveLockByAddressAndDate(ctx, address, lock.UnlockDate); err != nil {
+			return nil, err
+		}
+
+		if err := k.RemoveFromExpirationQueue(ctx, unlockDate, address, lock.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.LockupHooks().AfterLockRemoved(ctx, address, unlockDate, lock.Amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+			Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+			UnlockDate: types.FormatUnlockTime(unlockDate),
+			Amount:     lock.Amount,
+		}); err != nil {
+			return nil, err
+		}
+
+		released = released.Add(lock.Amount)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeForceUnlock,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, lock.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, lock.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		))
+	}
+
+	blockTime := ctx.BlockTime()
+	for _, schedule := range schedules {
+		if err := k.RemoveLockSchedule(ctx, address, schedule); err != nil {
+			return nil, err
+		}
+
+		locked := schedule.LockedAt(blockTime)
+		if locked.IsPositive() {
+			endTime := schedule.Schedule.EndTime()
+			if err := k.LockupHooks().AfterLockRemoved(ctx, address, endTime, locked); err != nil {
+				return nil, err
+			}
+
+			if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+				Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+				UnlockDate: types.FormatUnlockTime(endTime),
+				Amount:     locked,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		released = released.Add(locked)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeForceUnlock,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, locked.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgForceUnlockResponse{Released: sdk.NewCoin(bondDenom, released)}, nil
+}
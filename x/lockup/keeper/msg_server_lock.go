@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -33,20 +32,56 @@ func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLo
 		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid lock amount: %s", msg.Amount.String())
 	}
 
-	unlockDate, err := time.Parse(time.DateOnly, msg.UnlockDate)
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
 	}
 
 	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-	if blockDay.After(unlockDate) || blockDay.Equal(unlockDate) {
+	if !blockTime.Before(unlockDate) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date must be in the future")
 	}
 
-	if blockDay.AddDate(2, 0, 0).Before(unlockDate) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than 2 years from now")
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.MaxUnlockTime(blockTime).Before(unlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock date cannot be more than %d months from now", params.MaxLockMonths)
+	}
+
+	if msg.Amount.Amount.LT(params.MinLockAmount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "lock amount %s is below the minimum of %s", msg.Amount.Amount, params.MinLockAmount)
+	}
+
+	var valAddress sdk.ValAddress
+	if msg.ValidatorAddress != "" {
+		valAddress, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+		}
+
+		boundAmount, err := k.GetBoundAmount(ctx, address, valAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		delegatedAmount, err := k.GetDelegationAmount(ctx, address, valAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if delegatedAmount.LT(boundAmount.Add(msg.Amount.Amount)) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInsufficientDelegations,
+				"insufficient tokens delegated to %s to bind the lock: %s < %s",
+				msg.ValidatorAddress,
+				delegatedAmount.String(),
+				boundAmount.Add(msg.Amount.Amount).String(),
+			)
+		}
 	}
 
 	currentLockedAmount, err := k.GetLockedAmountByAddress(ctx, address)
@@ -68,13 +103,16 @@ func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLo
 		)
 	}
 
-	exisitingLock, idx, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+	exisitingLock, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
 	if found {
 		newAmount := exisitingLock.Amount.Add(msg.Amount.Amount)
-		if err = k.UpdateLockByAddressAndIndex(ctx, address, idx, &types.Lock{UnlockDate: exisitingLock.UnlockDate, Amount: newAmount}); err != nil {
+		if err = k.SetLockByAddress(ctx, address, &types.Lock{UnlockDate: exisitingLock.UnlockDate, Amount: newAmount}); err != nil {
 			return nil, err
 		}
 	} else {
+		if err := k.checkMaxUnlockDates(ctx, address, params); err != nil {
+			return nil, err
+		}
 
 		if err = k.SetLockByAddress(ctx, address, &types.Lock{UnlockDate: msg.UnlockDate, Amount: msg.Amount.Amount}); err != nil {
 			return nil, err
@@ -85,12 +123,31 @@ func (k msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLo
 		return nil, err
 	}
 
+	if valAddress != nil {
+		if err := k.bindLock(ctx, address, unlockDate, valAddress, msg.Amount.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.LockupHooks().AfterLockCreated(ctx, address, unlockDate, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+		Change:     types.LOCK_HISTORY_TYPE_CREATED,
+		UnlockDate: types.FormatUnlockTime(unlockDate),
+		Amount:     msg.Amount.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents([]sdk.Event{
 		sdk.NewEvent(
 			types.EventTypeLock,
 			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyUnlockDate, msg.UnlockDate),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, types.FormatUnlockTime(unlockDate)),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 		),
 	})
 
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// LockWithSchedule sends tokens to an address, delegates them and locks them
+// under a release schedule. The locked amount decreases step by step from the
+// cliff date onwards and is enforced like any other lock.
+func (k msgServer) LockWithSchedule(goCtx context.Context, msg *types.MsgLockWithSchedule) (*types.MsgLockWithScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.LockScheduleEnabled {
+		return nil, types.ErrLockScheduleDisabled
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount.String())
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	if err := msg.Schedule.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidSchedule, err.Error())
+	}
+
+	cliff, _ := types.ParseUnlockTime(msg.Schedule.CliffDate)
+
+	blockTime := ctx.BlockTime()
+
+	if !blockTime.Before(cliff) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("cliff date must be in the future")
+	}
+
+	if params.MaxUnlockTime(blockTime).Before(msg.Schedule.EndTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("last release cannot be more than %d months from now", params.MaxLockMonths)
+	}
+
+	if msg.Amount.Amount.LT(params.MinLockAmount) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "lock amount %s is below the minimum of %s", msg.Amount.Amount, params.MinLockAmount)
+	}
+
+	if msg.Amount.Amount.LT(math.NewInt(int64(msg.Schedule.Periods))) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSchedule, "lock amount %s cannot be split into %d release steps", msg.Amount.Amount, msg.Schedule.Periods)
+	}
+
+	if err := k.checkMaxLockSchedules(ctx, toAddr, params); err != nil {
+		return nil, err
+	}
+
+	err = k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(msg.Amount))
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	newShares, err := k.stakingKeeper.Delegate(ctx, toAddr, msg.Amount.Amount, stakingtypes.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delegate does not emit events, the staking msgServer does; emit the
+	// delegate event here as SendDelegateAndLock does.
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			stakingtypes.EventTypeDelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDelegator, msg.ToAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyNewShares, newShares.String()),
+		),
+	})
+
+	// Store the cliff date in its canonical form, like lock unlock dates.
+	schedule := msg.Schedule
+	schedule.CliffDate = types.FormatUnlockTime(cliff)
+
+	id, err := k.createLockSchedule(ctx, toAddr, msg.Amount.Amount, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().requireLocksCovered(ctx, toAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockWithScheduleResponse{Id: id}, nil
+}
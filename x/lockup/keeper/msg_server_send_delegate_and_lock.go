@@ -13,6 +13,15 @@ import (
 func (k msgServer) SendDelegateAndLock(goCtx context.Context, msg *types.MsgSendDelegateAndLock) (*types.MsgSendDelegateAndLockResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.SendDelegateAndLockEnabled {
+		return nil, types.ErrSendDelegateAndLockDisabled
+	}
+
 	if !msg.Amount.IsPositive() {
 		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid amount: %s", msg.Amount.String())
 	}
@@ -72,9 +81,10 @@ func (k msgServer) SendDelegateAndLock(goCtx context.Context, msg *types.MsgSend
 	_, err = k.Lock(
 		goCtx,
 		&types.MsgLock{
-			Address:    msg.ToAddress,
-			UnlockDate: msg.UnlockDate,
-			Amount:     msg.Amount,
+			Address:          msg.ToAddress,
+			UnlockDate:       msg.UnlockDate,
+			Amount:           msg.Amount,
+			ValidatorAddress: msg.ValidatorAddress,
 		},
 	)
 
@@ -2,51 +2,481 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 )
 
 func TestParams(t *testing.T) {
-	// f := SetupTest(t)
-	// require := require.New(t)
+	f := SetupTest(t)
+	require := require.New(t)
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MaxLockMonths = 0
+
+	tooManyMonths := types.DefaultParams()
+	tooManyMonths.MaxLockMonths = types.MaxMaxLockMonths + 1
 
 	testCases := []struct {
-		name string
-		// request *types.MsgUpdateParams
-		err bool
+		name    string
+		request *types.MsgUpdateParams
+		err     bool
 	}{
-		// {
-		// 	name: "fail; invalid authority",
-		// 	request: &types.MsgUpdateParams{
-		// 		Authority: f.addrs[0].String(),
-		// 		Params:    types.DefaultParams(),
-		// 	},
-		// 	err: true,
-		// },
-		// {
-		// 	name: "success",
-		// 	request: &types.MsgUpdateParams{
-		// 		Authority: f.govModAddr,
-		// 		Params:    types.DefaultParams(),
-		// 	},
-		// 	err: false,
-		// },
+		{
+			name: "fail; invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: f.addrs[0].String(),
+				Params:    types.DefaultParams(),
+			},
+			err: true,
+		},
+		{
+			name: "fail; invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params:    invalidParams,
+			},
+			err: true,
+		},
+		{
+			name: "fail; max lock months above the limit",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params:    tooManyMonths,
+			},
+			err: true,
+		},
+		{
+			name: "success",
+			request: &types.MsgUpdateParams{
+				Authority: f.govModAddr,
+				Params:    types.DefaultParams(),
+			},
+			err: false,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			// _, err := f.msgServer.UpdateParams(f.ctx, tc.request)
+			_, err := f.msgServer.UpdateParams(f.ctx, tc.request)
 
-			// if tc.err {
-			// 	require.Error(err)
-			// } else {
-			// 	require.NoError(err)
+			if tc.err {
+				require.Error(err)
+			} else {
+				require.NoError(err)
 
-			// 	r, err := f.queryServer.Params(f.ctx, &types.QueryParamsRequest{})
-			// 	require.NoError(err)
+				r, err := f.queryServer.Params(f.ctx, &types.QueryParamsRequest{})
+				require.NoError(err)
 
-			// 	require.EqualValues(&tc.request.Params, r.Params)
-			// }
+				require.EqualValues(tc.request.Params, r.Params)
+			}
 
 		})
 	}
 }
+
+func TestUnlockEarly(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(1000)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: addr.String(), Amount: math.NewInt(1000)},
+		},
+	}))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, addr, coins))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	msg := types.NewMsgUnlockEarly(addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 500))
+
+	// disabled by default
+	_, err := f.msgServer.UnlockEarly(ctx, msg)
+	require.ErrorIs(t, err, types.ErrEarlyUnlockDisabled)
+
+	params := types.DefaultParams()
+	params.EarlyUnlockEnabled = true
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	// penalty goes to the community pool
+	res, err := f.msgServer.UnlockEarly(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), res.Penalty.Amount)
+	require.Equal(t, math.NewInt(950), f.bankkeeper.GetBalance(ctx, addr, bondDenom).Amount)
+
+	feePool, err := f.distrkeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(50), feePool.CommunityPool.AmountOf(bondDenom))
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, addr, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(500), lock.Amount)
+
+	// penalty is burned, and the lock is fully released
+	params.BurnEarlyUnlockPenalty = true
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	supplyBefore := f.bankkeeper.GetSupply(ctx, bondDenom).Amount
+	res, err = f.msgServer.UnlockEarly(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, supplyBefore.Sub(res.Penalty.Amount), f.bankkeeper.GetSupply(ctx, bondDenom).Amount)
+
+	_, found = f.k.GetLockByAddressAndDate(ctx, addr, "2026-06-01")
+	require.False(t, found)
+
+	total, err := f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+
+	// nothing left to release
+	_, err = f.msgServer.UnlockEarly(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockupNotFound)
+}
+
+func TestTransferLock(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: from.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(1000)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: from.String(), Amount: math.NewInt(1000)},
+		},
+	}))
+	setDelegations(t, f, valAddr, map[string]int64{from.String(): 1500})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	msg := types.NewMsgTransferLock(from.String(), to.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 600), valAddr.String())
+
+	// restricted to the allowlist
+	params := types.DefaultParams()
+	params.LockTransferAllowlist = []string{to.String()}
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	_, err := f.msgServer.TransferLock(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockTransferNotAllowed)
+
+	params.LockTransferAllowlist = []string{from.String()}
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	// the recipient has to accept the transfers of the sender
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockTransferNotAccepted)
+
+	// a lock allowance of the recipient does not accept them
+	require.NoError(t, f.k.SetLockAllowance(ctx, to, from, math.NewInt(600), time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)))
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockTransferNotAccepted)
+
+	_, err = f.msgServer.AcceptLockTransfers(ctx, types.NewMsgAcceptLockTransfers(to.String(), from.String(), true))
+	require.NoError(t, err)
+
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.NoError(t, err)
+
+	// the allowance is left untouched
+	allowance, found, err := f.k.GetLockAllowance(ctx, to, from)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(600), allowance.MaxAmount)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, from, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(400), lock.Amount)
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, to, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(600), lock.Amount)
+
+	delegated, err := f.k.GetTotalDelegatedAmount(ctx, from)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(900), *delegated)
+
+	delegated, err = f.k.GetTotalDelegatedAmount(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), *delegated)
+
+	total, err := f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), total)
+
+	_, broken := keeper.AllInvariants(f.k)(ctx)
+	require.False(t, broken)
+
+	// the recipient refuses the transfers of the sender again
+	_, err = f.msgServer.AcceptLockTransfers(ctx, types.NewMsgAcceptLockTransfers(to.String(), from.String(), false))
+	require.NoError(t, err)
+
+	msg.Amount = sdk.NewInt64Coin(bondDenom, 100)
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockTransferNotAccepted)
+
+	require.NoError(t, f.k.SetLockTransferAcceptance(ctx, to, from, true))
+
+	// more than the remaining lock
+	msg.Amount = sdk.NewInt64Coin(bondDenom, 600)
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.Error(t, err)
+
+	params.LockTransferEnabled = false
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	_, err = f.msgServer.TransferLock(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockTransferDisabled)
+}
+
+func TestTransferLockRounding(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: from.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(1000)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: from.String(), Amount: math.NewInt(1000)},
+		},
+	}))
+
+	// 3000 shares worth 7000 tokens: the shares of 100 tokens are worth
+	// slightly less than 100 tokens
+	require.NoError(t, f.stakingKeeper.SetDelegation(f.ctx, stakingtypes.NewDelegation(from.String(), valAddr.String(), math.LegacyNewDec(3000))))
+	require.NoError(t, f.stakingKeeper.SetValidator(f.ctx, stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          math.NewInt(7000),
+		DelegatorShares: math.LegacyNewDec(3000),
+	}))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.SetLockTransferAcceptance(ctx, to, from, true))
+
+	_, err := f.msgServer.TransferLock(ctx, types.NewMsgTransferLock(from.String(), to.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 100), valAddr.String()))
+	require.NoError(t, err)
+
+	// the recipient locks exactly the tokens it received
+	delegated, err := f.k.GetTotalDelegatedAmount(ctx, to)
+	require.NoError(t, err)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, to, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(99), lock.Amount)
+	require.True(t, delegated.GTE(lock.Amount))
+
+	lock, found = f.k.GetLockByAddressAndDate(ctx, from, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(900), lock.Amount)
+
+	_, broken := keeper.AllInvariants(f.k)(ctx)
+	require.False(t, broken)
+}
+
+func TestForceUnlockAndAdjustLock(t *testing.T) {
+	f := SetupTest(t)
+
+	addr := f.addrs[0]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr.String(), Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(400)},
+				{UnlockDate: "2026-09-01", Amount: math.NewInt(600)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: addr.String(), Amount: math.NewInt(400)},
+			{UnlockDate: "2026-09-01", Address: addr.String(), Amount: math.NewInt(600)},
+		},
+	}))
+	setDelegations(t, f, valAddr, map[string]int64{addr.String(): 1200})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	// only the authority may adjust locks
+	_, err := f.msgServer.AdjustLock(ctx, types.NewMsgAdjustLock(addr.String(), addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 100), ""))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// increases must stay covered by delegations
+	_, err = f.msgServer.AdjustLock(ctx, types.NewMsgAdjustLock(f.govModAddr, addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 700), "batch grant fix"))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	_, err = f.msgServer.AdjustLock(ctx, types.NewMsgAdjustLock(f.govModAddr, addr.String(), "2026-06-01", sdk.NewInt64Coin(bondDenom, 100), "batch grant fix"))
+	require.NoError(t, err)
+
+	lock, found := f.k.GetLockByAddressAndDate(ctx, addr, "2026-06-01")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(100), lock.Amount)
+
+	_, err = f.msgServer.AdjustLock(ctx, types.NewMsgAdjustLock(f.govModAddr, addr.String(), "2026-12-01", sdk.NewInt64Coin(bondDenom, 500), "batch grant fix"))
+	require.NoError(t, err)
+
+	total, err := f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1200), total)
+
+	_, broken := keeper.AllInvariants(f.k)(ctx)
+	require.False(t, broken)
+
+	// a single date
+	res, err := f.msgServer.ForceUnlock(ctx, types.NewMsgForceUnlock(f.govModAddr, addr.String(), "2026-09-01", "legal order"))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), res.Released.Amount)
+
+	// every remaining date
+	res, err = f.msgServer.ForceUnlock(ctx, types.NewMsgForceUnlock(f.govModAddr, addr.String(), "", "compromised key"))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), res.Released.Amount)
+
+	locks, err := f.k.GetLocksByAddress(ctx, addr)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	total, err = f.k.GetTotalLocked(ctx)
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+
+	_, err = f.msgServer.ForceUnlock(ctx, types.NewMsgForceUnlock(f.govModAddr, addr.String(), "", "compromised key"))
+	require.ErrorIs(t, err, types.ErrLockupNotFound)
+}
+
+func TestLockWithSchedule(t *testing.T) {
+	f := SetupTest(t)
+
+	from := f.addrs[0]
+	to := f.addrs[1]
+	valAddr := sdk.ValAddress(f.addrs[2])
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, types.DefaultGenesis()))
+	setDelegations(t, f, valAddr, map[string]int64{})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+	require.NoError(t, f.bankkeeper.MintCoins(f.ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(f.ctx, minttypes.ModuleName, from, coins))
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)).WithTxBytes([]byte{1})
+	schedule := types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 4}
+	msg := types.NewMsgLockWithSchedule(from.String(), to.String(), valAddr.String(), sdk.NewInt64Coin(bondDenom, 1000), schedule)
+
+	// the cliff must be in the future
+	past := *msg
+	past.Schedule.CliffDate = "2026-01-01"
+	_, err := f.msgServer.LockWithSchedule(ctx, &past)
+	require.Error(t, err)
+
+	// the last release must be within max_lock_months
+	tooLong := *msg
+	tooLong.Schedule.Periods = 24
+	_, err = f.msgServer.LockWithSchedule(ctx, &tooLong)
+	require.Error(t, err)
+
+	res, err := f.msgServer.LockWithSchedule(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Id)
+
+	delegated, err := f.k.GetTotalDelegatedAmount(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), *delegated)
+
+	status, err := f.k.LockStatus(ctx, &types.QueryLockStatusRequest{Address: to.String()})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), status.Locked.Amount)
+	require.Equal(t, "2026-03-01", status.NextUnlockDate)
+	require.Equal(t, math.NewInt(250), status.NextUnlockAmount.Amount)
+	require.Len(t, status.Schedules, 1)
+
+	// two steps released
+	ctx = ctx.WithBlockTime(time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC))
+
+	status, err = f.k.LockStatus(ctx, &types.QueryLockStatusRequest{Address: to.String()})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), status.Locked.Amount)
+	require.Equal(t, "2026-05-01", status.NextUnlockDate)
+	require.Equal(t, math.NewInt(250), status.NextUnlockAmount.Amount)
+
+	total, err := f.k.TotalLockedAmount(ctx, &types.QueryTotalLockedAmountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), total.TotalLocked.Amount)
+
+	// the staking hooks keep the locked part delegated
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = f.stakingKeeper.Unbond(cacheCtx, to, valAddr, math.LegacyNewDec(600))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	_, err = f.stakingKeeper.Unbond(ctx, to, valAddr, math.LegacyNewDec(500))
+	require.NoError(t, err)
+
+	_, broken := keeper.AllInvariants(f.k)(ctx)
+	require.False(t, broken)
+
+	// the schedule is removed once its last step is released
+	ctx = ctx.WithBlockTime(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.EndBlocker(ctx))
+
+	schedules, err := f.k.GetLockSchedules(ctx, to)
+	require.NoError(t, err)
+	require.Empty(t, schedules)
+
+	genState := f.k.ExportGenesis(ctx)
+	require.Empty(t, genState.LockSchedules)
+	require.Equal(t, uint64(1), genState.NextScheduleId)
+
+	// the recipient can hold at most max_lock_schedules schedules
+	params := types.DefaultParams()
+	params.MaxLockSchedules = 1
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	require.NoError(t, f.bankkeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, f.bankkeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, from, coins))
+
+	ctx = ctx.WithBlockTime(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+	msg.Schedule.CliffDate = "2026-09-01"
+	_, err = f.msgServer.LockWithSchedule(ctx, msg)
+	require.NoError(t, err)
+
+	_, err = f.msgServer.LockWithSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrTooManyLockSchedules)
+
+	params.LockScheduleEnabled = false
+	require.NoError(t, f.k.Params.Set(ctx, params))
+
+	_, err = f.msgServer.LockWithSchedule(ctx, msg)
+	require.ErrorIs(t, err, types.ErrLockScheduleDisabled)
+}
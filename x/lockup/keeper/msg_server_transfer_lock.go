@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TransferLock moves part or all of a lock to another address together with
+// the delegation backing it. The delegation shares are unbonded from the
+// sender and bonded again for the recipient on the same validator, the way a
+// redelegation moves tokens, so no tokens leave the staking pools. The
+// recipient must accept the lock transfers of the sender with
+// MsgAcceptLockTransfers.
+func (k msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.LockTransferEnabled {
+		return nil, types.ErrLockTransferDisabled
+	}
+
+	if !params.IsLockTransferAllowed(msg.FromAddress) {
+		return nil, types.ErrLockTransferNotAllowed.Wrapf("address %s is not in the lock transfer allowlist", msg.FromAddress)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	toAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if fromAddress.Equals(toAddress) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("cannot transfer a lock to the same address")
+	}
+
+	valAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid transfer amount: %s", msg.Amount.String())
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock on %s is already unlocked", msg.UnlockDate)
+	}
+
+	existingLock, found := k.GetLockByAddressAndDate(ctx, fromAddress, msg.UnlockDate)
+	if !found {
+		return nil, types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", msg.UnlockDate)
+	}
+
+	if existingLock.Amount.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("transfer amount exceeds existing lock amount date (%s)", msg.UnlockDate)
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := k.stakingKeeper.GetDelegation(ctx, fromAddress, valAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientDelegations, "no delegation from %s to %s", msg.FromAddress, msg.ValidatorAddress)
+	}
+
+	if validator.TokensFromShares(delegation.Shares).Ceil().TruncateInt().LT(msg.Amount.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInsufficientDelegations,
+			"delegation to %s is smaller than the transfer amount %s",
+			msg.ValidatorAddress,
+			msg.Amount.Amount.String(),
+		)
+	}
+
+	shares, err := validator.SharesFromTokens(msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	accepted, err := k.AcceptsLockTransfers(ctx, toAddress, fromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, types.ErrLockTransferNotAccepted.Wrapf("%s does not accept lock transfers from %s", msg.ToAddress, msg.FromAddress)
+	}
+
+	// The sender's lock is reduced first, so that the staking hooks fired by
+	// the unbonding below already see it. A binding of the sender to the
+	// validator is released first.
+	if _, err := k.trimLockBindings(ctx, fromAddress, unlockDate, existingLock.Amount.Sub(msg.Amount.Amount), valAddress); err != nil {
+		return nil, err
+	}
+
+	if existingLock.Amount.Equal(msg.Amount.Amount) {
+		err = k.RemoveLockByAddressAndDate(ctx, fromAddress, msg.UnlockDate)
+	} else {
+		err = k.SetLockByAddress(ctx, fromAddress, &types.Lock{
+			UnlockDate: existingLock.UnlockDate,
+			Amount:     existingLock.Amount.Sub(msg.Amount.Amount),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveFromExpirationQueue(ctx, unlockDate, fromAddress, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	lockupHooks := k.LockupHooks()
+	if err := lockupHooks.AfterLockRemoved(ctx, fromAddress, unlockDate, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	// Unbonding rounds the tokens of the shares down, so the recipient may
+	// receive slightly less than the amount. Exactly the tokens moved are
+	// locked for the recipient; the rounding dust is released.
+	amount, err := k.stakingKeeper.Unbond(ctx, fromAddress, valAddress, shares)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to move delegation")
+	}
+
+	if !amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("transfer amount %s is worth no tokens of %s", msg.Amount.Amount, msg.ValidatorAddress)
+	}
+
+	recipientLock, found := k.GetLockByAddressAndDate(ctx, toAddress, msg.UnlockDate)
+	if found {
+		err = k.SetLockByAddress(ctx, toAddress, &types.Lock{
+			UnlockDate: recipientLock.UnlockDate,
+			Amount:     recipientLock.Amount.Add(amount),
+		})
+	} else {
+		if err := k.checkMaxUnlockDates(ctx, toAddress, params); err != nil {
+			return nil, err
+		}
+
+		err = k.SetLockByAddress(ctx, toAddress, &types.Lock{UnlockDate: msg.UnlockDate, Amount: amount})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AddToExpirationQueue(ctx, unlockDate, toAddress, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.bindLock(ctx, toAddress, unlockDate, valAddress, amount); err != nil {
+		return nil, err
+	}
+
+	if err := lockupHooks.AfterLockCreated(ctx, toAddress, unlockDate, amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordLockHistory(ctx, fromAddress, types.LockHistoryEntry{
+		Change:       types.LOCK_HISTORY_TYPE_TRANSFERRED_OUT,
+		UnlockDate:   types.FormatUnlockTime(unlockDate),
+		Amount:       msg.Amount.Amount,
+		Counterparty: msg.ToAddress,
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.recordLockHistory(ctx, toAddress, types.LockHistoryEntry{
+		Change:       types.LOCK_HISTORY_TYPE_TRANSFERRED_IN,
+		UnlockDate:   types.FormatUnlockTime(unlockDate),
+		Amount:       amount,
+		Counterparty: msg.FromAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Unbond may have changed the validator's tokens and shares.
+	validator, err = k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, toAddress, amount, validator.GetStatus(), validator, false); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to move delegation")
+	}
+
+	hooks := k.Hooks()
+	if err := hooks.requireLocksCovered(ctx, fromAddress); err != nil {
+		return nil, err
+	}
+	if err := hooks.requireLocksCovered(ctx, toAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeLockTransfer,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, types.FormatUnlockTime(unlockDate)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{}, nil
+}
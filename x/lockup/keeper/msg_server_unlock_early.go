@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) UnlockEarly(goCtx context.Context, msg *types.MsgUnlockEarly) (*types.MsgUnlockEarlyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !params.EarlyUnlockEnabled {
+		return nil, types.ErrEarlyUnlockDisabled
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid denom: %s, expected: %s", msg.Amount.Denom, bondDenom)
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid lockup address: %s", err)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrapf("invalid unlock amount: %s", msg.Amount.String())
+	}
+
+	unlockDate, err := types.ParseUnlockTime(msg.UnlockDate)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid unlock date format: %s", msg.UnlockDate)
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if !types.IsLocked(blockTime, msg.UnlockDate) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("lock on %s is already unlocked", msg.UnlockDate)
+	}
+
+	existingLock, found := k.GetLockByAddressAndDate(ctx, address, msg.UnlockDate)
+	if !found {
+		return nil, types.ErrLockupNotFound.Wrapf("no lockup found for unlock date (%s)", msg.UnlockDate)
+	}
+
+	if existingLock.Amount.LT(msg.Amount.Amount) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unlock amount exceeds existing lock amount date (%s)", msg.UnlockDate)
+	} else if existingLock.Amount.Equal(msg.Amount.Amount) {
+		err = k.RemoveLockByAddressAndDate(ctx, address, msg.UnlockDate)
+	} else {
+		err = k.SetLockByAddress(ctx, address, &types.Lock{
+			UnlockDate: existingLock.UnlockDate,
+			Amount:     existingLock.Amount.Sub(msg.Amount.Amount),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RemoveFromExpirationQueue(ctx, unlockDate, address, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.LockupHooks().AfterLockRemoved(ctx, address, unlockDate, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.recordLockHistory(ctx, address, types.LockHistoryEntry{
+		Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+		UnlockDate: types.FormatUnlockTime(unlockDate),
+		Amount:     msg.Amount.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	// The lock is released before the penalty is charged, so the send
+	// restriction already sees the remaining locks only.
+	penalty := sdk.NewCoin(bondDenom, params.EarlyUnlockPenaltyRate.MulInt(msg.Amount.Amount).Ceil().TruncateInt())
+	if penalty.IsPositive() {
+		if params.BurnEarlyUnlockPenalty {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, sdk.NewCoins(penalty)); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to pay early unlock penalty")
+			}
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(penalty)); err != nil {
+				return nil, err
+			}
+		} else {
+			if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(penalty), address); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to pay early unlock penalty")
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvents([]sdk.Event{
+		sdk.NewEvent(
+			types.EventTypeUnlockEarly,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, types.FormatUnlockTime(unlockDate)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPenaltyBurned, strconv.FormatBool(params.BurnEarlyUnlockPenalty)),
+		),
+	})
+
+	return &types.MsgUnlockEarlyResponse{Penalty: penalty}, nil
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
@@ -26,6 +29,19 @@ func NewQuerier(keeper Keeper) Querier {
 	return Querier{Keeper: keeper}
 }
 
+func (k Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.GetParams(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
 func (k Keeper) ActiveLocks(goCtx context.Context, req *types.QueryActiveLocksRequest) (*types.QueryActiveLocksResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -38,6 +54,11 @@ func (k Keeper) ActiveLocks(goCtx context.Context, req *types.QueryActiveLocksRe
 
 	var startKey []byte
 	if req.Pagination != nil && len(req.Pagination.Key) != 0 {
+		// The key is a raw store key: anything outside the expiration queue
+		// would be decoded as a lock.
+		if !bytes.HasPrefix(req.Pagination.Key, types.LocksByDateKey) {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
 		startKey = req.Pagination.Key
 	} else {
 		startKey = types.LocksByDateKey
@@ -63,37 +84,22 @@ func (k Keeper) ActiveLocks(goCtx context.Context, req *types.QueryActiveLocksRe
 			break
 		}
 
-		key := iterator.Key()
-		value := iterator.Value()
-
-		// Decode
-		// Key: Prefix + Timestamp (8) + Address
-		prefixLen := len(types.LocksByDateKey)
-		if len(key) < prefixLen+8 {
+		addr, unlockTime, amount, ok, err := decodeExpirationQueueEntry(iterator.Key(), iterator.Value())
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to unmarshal amount")
+		}
+		if !ok {
 			continue
 		}
 
-		timeBz := key[prefixLen : prefixLen+8]
-		addrBz := key[prefixLen+8:]
-
-		unlockUnix := binary.BigEndian.Uint64(timeBz)
-		unlockTime := time.Unix(int64(unlockUnix), 0)
-		unlockDate := unlockTime.UTC().Format(time.DateOnly)
+		unlockDate := types.FormatUnlockTime(unlockTime)
 
 		blockTime := ctx.BlockTime()
-		blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
 
-		if !types.IsLocked(blockDay, unlockDate) {
+		if !types.IsLocked(blockTime, unlockDate) {
 			continue
 		}
 
-		addr := sdk.AccAddress(addrBz)
-
-		var amount math.Int
-		if err := amount.Unmarshal(value); err != nil {
-			return nil, status.Error(codes.Internal, "failed to unmarshal amount")
-		}
-
 		bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -123,27 +129,124 @@ func (k Keeper) TotalLockedAmount(goCtx context.Context, req *types.QueryTotalLo
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	totalLocked := math.ZeroInt()
+	// Served from the running aggregate; entries are removed from it by the
+	// EndBlocker once their unlock date is reached. Lock schedules release
+	// gradually and are added at their current locked amount.
+	totalLocked, err := k.GetTotalLocked(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+	scheduled, err := k.GetTotalScheduledLocked(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalLocked = totalLocked.Add(scheduled)
 
-	err := k.IterateActiveLocks(ctx, blockDay, func(addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
-		totalLocked = totalLocked.Add(amount)
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid bond denom: %s", err)
+	}
+
+	return &types.QueryTotalLockedAmountResponse{
+		TotalLocked: sdk.NewCoin(bondDenom, totalLocked),
+	}, nil
+}
+
+func (k Keeper) UnlockSchedule(goCtx context.Context, req *types.QueryUnlockScheduleRequest) (*types.QueryUnlockScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	calendar, err := newUnlockCalendar(ctx, req.FromDate, req.ToDate, req.Bucket)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = k.IterateExpirationQueueBetween(ctx, calendar.from, calendar.to, func(_ sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+		calendar.add(unlockTime, amount)
 		return nil
 	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
+	err = k.walkSchedulesReleasingBefore(ctx, calendar.to, func(schedule types.LockSchedule) error {
+		calendar.addSchedule(schedule)
+		return nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
-		return nil, sdkerrors.ErrInvalidType.Wrapf("invalid bond denom: %s", err)
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryTotalLockedAmountResponse{
-		TotalLocked: sdk.NewCoin(bondDenom, totalLocked),
+	buckets, total := calendar.buckets(bondDenom)
+
+	return &types.QueryUnlockScheduleResponse{
+		Buckets: buckets,
+		Total:   total,
+	}, nil
+}
+
+func (k Keeper) UnlockScheduleByAddress(goCtx context.Context, req *types.QueryUnlockScheduleByAddressRequest) (*types.QueryUnlockScheduleByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	calendar, err := newUnlockCalendar(ctx, req.FromDate, req.ToDate, req.Bucket)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, lock := range locks {
+		unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		calendar.add(unlockTime, lock.Amount)
+	}
+
+	schedules, err := k.GetLockSchedules(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, schedule := range schedules {
+		calendar.addSchedule(schedule)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	buckets, total := calendar.buckets(bondDenom)
+
+	return &types.QueryUnlockScheduleByAddressResponse{
+		Buckets: buckets,
+		Total:   total,
 	}, nil
 }
 
@@ -161,56 +264,68 @@ func (k Keeper) AccountLocks(goCtx context.Context, req *types.QueryAccountLocks
 		addressList[i] = strings.TrimSpace(addr)
 	}
 
-	if len(addressList) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
+	accountLocks, pageRes, err := k.accountLocks(sdk.UnwrapSDKContext(goCtx), addressList, req.Pagination)
+	if err != nil {
+		return nil, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryAccountLocksResponse{
+		Accounts:   accountLocks,
+		Pagination: pageRes,
+	}, nil
+}
 
-	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+func (k Keeper) AccountLocksBatch(goCtx context.Context, req *types.QueryAccountLocksBatchRequest) (*types.QueryAccountLocksBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one address is required")
+	}
 
-	// Apply pagination
-	limit := uint64(100)
-	offset := uint64(0)
+	accountLocks, pageRes, err := k.accountLocks(sdk.UnwrapSDKContext(goCtx), req.Addresses, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
 
-	if req.Pagination != nil {
-		if req.Pagination.Limit != 0 {
-			limit = req.Pagination.Limit
-		}
-		if req.Pagination.Offset != 0 {
-			offset = req.Pagination.Offset
-		}
+	return &types.QueryAccountLocksBatchResponse{
+		Accounts:   accountLocks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// accountLocks returns the active locks of a page of addressList
+func (k Keeper) accountLocks(ctx sdk.Context, addressList []string, pageReq *query.PageRequest) ([]types.AccountLocksResource, *query.PageResponse, error) {
+	start, end, pageRes, err := paginateList(len(addressList), pageReq)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	startIdx := offset
-	endIdx := offset + limit
-	if endIdx > uint64(len(addressList)) {
-		endIdx = uint64(len(addressList))
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
 
-	paginatedAddresses := addressList[startIdx:endIdx]
+	blockTime := ctx.BlockTime()
+
+	paginatedAddresses := addressList[start:end]
 	accountLocks := make([]types.AccountLocksResource, 0, len(paginatedAddresses))
 
 	for _, addrStr := range paginatedAddresses {
 		addr, err := sdk.AccAddressFromBech32(addrStr)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "invalid address: "+addrStr)
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid address: "+addrStr)
 		}
 
 		locks, err := k.GetLocksByAddress(ctx, addr)
 		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
+			return nil, nil, status.Error(codes.Internal, err.Error())
 		}
 
 		activeLockResources := make([]types.LockResource, 0)
 		for _, lock := range locks {
-			if types.IsLocked(blockDay, lock.UnlockDate) {
+			if types.IsLocked(blockTime, lock.UnlockDate) {
 				activeLockResources = append(activeLockResources, types.LockResource{
 					UnlockDate: lock.UnlockDate,
 					Amount:     sdk.NewCoin(bondDenom, lock.Amount),
@@ -224,18 +339,7 @@ func (k Keeper) AccountLocks(goCtx context.Context, req *types.QueryAccountLocks
 		})
 	}
 
-	var nextKey []byte
-	if endIdx < uint64(len(addressList)) {
-		nextKey = []byte{1} // Indicate more results available
-	}
-
-	return &types.QueryAccountLocksResponse{
-		Accounts: accountLocks,
-		Pagination: &query.PageResponse{
-			NextKey: nextKey,
-			Total:   uint64(len(addressList)),
-		},
-	}, nil
+	return accountLocks, pageRes, nil
 }
 
 // Locks implements types.QueryServer.
@@ -255,64 +359,276 @@ func (k Keeper) Locks(goCtx context.Context, req *types.QueryLocksRequest) (*typ
 		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
 	}
 
-	locks, err := k.GetLocksByAddress(ctx, addr)
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	blockTime := ctx.BlockTime()
-	blockDay := time.Date(blockTime.Year(), blockTime.Month(), blockTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	locks, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.LocksByAddress,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, time.Time], _ math.Int) (bool, error) {
+			return blockTime.Before(key.K2()), nil
+		},
+		func(key collections.Pair[sdk.AccAddress, time.Time], amount math.Int) (types.LockResource, error) {
+			return types.LockResource{
+				UnlockDate: types.FormatUnlockTime(key.K2()),
+				Amount:     sdk.NewCoin(bondDenom, amount),
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, time.Time](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLocksResponse{
+		Locks:      locks,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) LocksByValidator(goCtx context.Context, req *types.QueryLocksByValidatorRequest) (*types.QueryLocksByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address: "+req.ValidatorAddress)
+	}
+
+	totalLocked, err := k.GetValidatorBoundTotal(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	activeLockResources := make([]types.LockResource, 0)
-	for _, lock := range locks {
-		if types.IsLocked(blockDay, lock.UnlockDate) {
-			activeLockResources = append(activeLockResources, types.LockResource{
-				UnlockDate: lock.UnlockDate,
-				Amount:     sdk.NewCoin(bondDenom, lock.Amount),
-			})
-		}
+	blockTime := ctx.BlockTime()
+
+	locks, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.LockBindingsByValidator,
+		req.Pagination,
+		func(key collections.Triple[sdk.ValAddress, sdk.AccAddress, time.Time], _ collections.NoValue) (bool, error) {
+			return blockTime.Before(key.K3()), nil
+		},
+		func(key collections.Triple[sdk.ValAddress, sdk.AccAddress, time.Time], _ collections.NoValue) (types.LockBinding, error) {
+			amount, err := k.LockBindings.Get(ctx, bindingKey(key.K2(), key.K3(), valAddr))
+			if err != nil {
+				return types.LockBinding{}, err
+			}
+
+			return types.LockBinding{
+				Address:          key.K2().String(),
+				UnlockDate:       types.FormatUnlockTime(key.K3()),
+				ValidatorAddress: valAddr.String(),
+				Amount:           amount,
+			}, nil
+		},
+		withCollectionPaginationTriplePrefix[sdk.ValAddress, sdk.AccAddress, time.Time](valAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Apply pagination
-	limit := uint64(100)
-	offset := uint64(0)
+	return &types.QueryLocksByValidatorResponse{
+		Locks:       locks,
+		TotalLocked: sdk.NewCoin(bondDenom, totalLocked),
+		Pagination:  pageRes,
+	}, nil
+}
 
-	if req.Pagination != nil {
-		if req.Pagination.Limit != 0 {
-			limit = req.Pagination.Limit
-		}
-		if req.Pagination.Offset != 0 {
-			offset = req.Pagination.Offset
-		}
+func (k Keeper) LockHistory(goCtx context.Context, req *types.QueryLockHistoryRequest) (*types.QueryLockHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	startIdx := offset
-	endIdx := offset + limit
-	if endIdx > uint64(len(activeLockResources)) {
-		endIdx = uint64(len(activeLockResources))
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
 	}
 
-	paginatedLocks := activeLockResources[startIdx:endIdx]
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	var nextKey []byte
-	if endIdx < uint64(len(activeLockResources)) {
-		nextKey = []byte{1} // Indicate more results available
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
 	}
 
-	return &types.QueryLocksResponse{
-		Locks: paginatedLocks,
-		Pagination: &query.PageResponse{
-			NextKey: nextKey,
-			Total:   uint64(len(activeLockResources)),
+	entries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.LockHistoryEntries,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], entry types.LockHistoryEntry) (types.LockHistoryEntry, error) {
+			return entry, nil
 		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryLockHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
 	}, nil
 }
 
+func (k Keeper) SpendableBondBalance(goCtx context.Context, req *types.QuerySpendableBondBalanceRequest) (*types.QuerySpendableBondBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	spendable, err := k.GetSpendableBondBalance(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	delegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendableBondBalanceResponse{
+		Spendable: sdk.NewCoin(bondDenom, spendable),
+		Balance:   k.bankKeeper.GetBalance(ctx, addr, bondDenom),
+		Locked:    sdk.NewCoin(bondDenom, *locked),
+		Delegated: sdk.NewCoin(bondDenom, *delegated),
+	}, nil
+}
+
+func (k Keeper) MaxUndelegatable(goCtx context.Context, req *types.QueryMaxUndelegatableRequest) (*types.QueryMaxUndelegatableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address: "+req.ValidatorAddress)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	amount, err := k.GetMaxUndelegatable(ctx, addr, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMaxUndelegatableResponse{
+		Amount: sdk.NewCoin(bondDenom, amount),
+	}, nil
+}
+
+func (k Keeper) LockAllowance(goCtx context.Context, req *types.QueryLockAllowanceRequest) (*types.QueryLockAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Owner == "" || req.Spender == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner and spender are required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address: "+req.Owner)
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid spender address: "+req.Spender)
+	}
+
+	allowance, found, err := k.GetLockAllowance(ctx, owner, spender)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no lock allowance of %s for %s", req.Owner, req.Spender)
+	}
+
+	return &types.QueryLockAllowanceResponse{Allowance: allowance}, nil
+}
+
+func (k Keeper) LockTransferAcceptance(goCtx context.Context, req *types.QueryLockTransferAcceptanceRequest) (*types.QueryLockTransferAcceptanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" || req.FromAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "address and from address are required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	fromAddress, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from address: "+req.FromAddress)
+	}
+
+	accepted, err := k.AcceptsLockTransfers(ctx, address, fromAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLockTransferAcceptanceResponse{Accepted: accepted}, nil
+}
+
 func prefixEndBytes(prefix []byte) []byte {
 	if len(prefix) == 0 {
 		return nil
@@ -327,3 +643,180 @@ func prefixEndBytes(prefix []byte) []byte {
 	}
 	return nil
 }
+
+// withCollectionPaginationTriplePrefix applies a prefix to a collection whose
+// key is a collections.Triple, like query.WithCollectionPaginationPairPrefix.
+func withCollectionPaginationTriplePrefix[K1, K2, K3 any](prefix K1) func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+		prefix := collections.TriplePrefix[K1, K2, K3](prefix)
+		o.Prefix = &prefix
+	}
+}
+
+// paginateList applies pageReq to a list of length items held in memory and
+// returns the bounds of the page. The key of the next page is the big endian
+// index it starts at. Like query.Paginate, the total is counted when asked
+// for on the first page, or when no limit is set.
+func paginateList(length int, pageReq *query.PageRequest) (start, end uint64, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return 0, 0, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if pageReq.Reverse {
+		return 0, 0, nil, fmt.Errorf("reverse pagination is not supported")
+	}
+
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	total := uint64(length)
+	start = pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, fmt.Errorf("invalid pagination key")
+		}
+		start = binary.BigEndian.Uint64(pageReq.Key)
+		countTotal = false
+	}
+
+	start = min(start, total)
+	end = total
+	if limit < total-start {
+		end = start + limit
+	}
+
+	pageRes = &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return start, end, pageRes, nil
+}
+
+func (k Keeper) SlashAdjustments(goCtx context.Context, req *types.QuerySlashAdjustmentsRequest) (*types.QuerySlashAdjustmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	slashed, err := k.GetSlashedLocks(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlashAdjustmentsResponse{
+		SlashedAmount: sdk.NewCoin(bondDenom, slashed),
+	}, nil
+}
+
+func (k Keeper) LockStatus(goCtx context.Context, req *types.QueryLockStatusRequest) (*types.QueryLockStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address: "+req.Address)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locked, err := k.GetLockedAmountByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	schedules, err := k.GetLockSchedules(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blockTime := ctx.BlockTime()
+
+	// The next unlock is the earliest release of a lock or a schedule step;
+	// everything released at that same time is added up.
+	var nextUnlock time.Time
+	nextAmount := math.ZeroInt()
+	addRelease := func(releaseTime time.Time, amount math.Int) {
+		switch {
+		case nextUnlock.IsZero() || releaseTime.Before(nextUnlock):
+			nextUnlock, nextAmount = releaseTime, amount
+		case releaseTime.Equal(nextUnlock):
+			nextAmount = nextAmount.Add(amount)
+		}
+	}
+
+	for _, lock := range locks {
+		if !types.IsLocked(blockTime, lock.UnlockDate) {
+			continue
+		}
+		unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		addRelease(unlockTime, lock.Amount)
+		// locks are ordered by unlock date
+		break
+	}
+
+	activeSchedules := make([]types.LockSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		releaseTime, amount, found := schedule.NextRelease(blockTime)
+		if !found {
+			continue
+		}
+		addRelease(releaseTime, amount)
+		activeSchedules = append(activeSchedules, schedule)
+	}
+
+	res := &types.QueryLockStatusResponse{
+		Locked:           sdk.NewCoin(bondDenom, *locked),
+		NextUnlockAmount: sdk.NewCoin(bondDenom, nextAmount),
+		Schedules:        activeSchedules,
+	}
+	if !nextUnlock.IsZero() {
+		res.NextUnlockDate = types.FormatUnlockTime(nextUnlock)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLocksPagination(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(10)},
+				{UnlockDate: "2026-03-01", Amount: math.NewInt(20)},
+				{UnlockDate: "2026-04-01", Amount: math.NewInt(30)},
+				{UnlockDate: "2026-05-01", Amount: math.NewInt(40)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(10)},
+			{UnlockDate: "2026-03-01", Address: addr1, Amount: math.NewInt(20)},
+			{UnlockDate: "2026-04-01", Address: addr1, Amount: math.NewInt(30)},
+			{UnlockDate: "2026-05-01", Address: addr1, Amount: math.NewInt(40)},
+			{UnlockDate: "2026-06-01", Address: addr2, Amount: math.NewInt(50)},
+		},
+	}))
+
+	// the 2026-01-01 lock has expired but is not pruned yet
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	res, err := f.queryServer.Locks(ctx, &types.QueryLocksRequest{
+		Address:    addr1,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-03-01", "2026-04-01"}, lockDates(res.Locks))
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	// the next key picks up where the first page stopped, without going into
+	// the locks of the next address
+	res, err = f.queryServer.Locks(ctx, &types.QueryLocksRequest{
+		Address:    addr1,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-05-01"}, lockDates(res.Locks))
+	require.Empty(t, res.Pagination.NextKey)
+
+	// like query.CollectionFilteredPaginate, an offset skips stored locks,
+	// expired or not
+	res, err = f.queryServer.Locks(ctx, &types.QueryLocksRequest{
+		Address:    addr1,
+		Pagination: &query.PageRequest{Offset: 3},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-05-01"}, lockDates(res.Locks))
+
+	_, err = f.queryServer.Locks(ctx, &types.QueryLocksRequest{
+		Address:    addr1,
+		Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+	})
+	require.Error(t, err)
+
+	// the comma separated and batch variants page through the addresses
+	accountsRes, err := f.queryServer.AccountLocks(ctx, &types.QueryAccountLocksRequest{
+		Addresses:  addr1 + ", " + addr2,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, accountsRes.Accounts, 1)
+	require.Equal(t, addr1, accountsRes.Accounts[0].Address)
+	require.Equal(t, []string{"2026-03-01", "2026-04-01", "2026-05-01"}, lockDates(accountsRes.Accounts[0].Locks))
+	require.Equal(t, uint64(2), accountsRes.Pagination.Total)
+
+	batchRes, err := f.queryServer.AccountLocksBatch(ctx, &types.QueryAccountLocksBatchRequest{
+		Addresses:  []string{addr1, addr2},
+		Pagination: &query.PageRequest{Key: accountsRes.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, batchRes.Accounts, 1)
+	require.Equal(t, addr2, batchRes.Accounts[0].Address)
+	require.Equal(t, []string{"2026-06-01"}, lockDates(batchRes.Accounts[0].Locks))
+	require.Empty(t, batchRes.Pagination.NextKey)
+
+	_, err = f.queryServer.AccountLocksBatch(ctx, &types.QueryAccountLocksBatchRequest{
+		Addresses:  []string{addr1, addr2},
+		Pagination: &query.PageRequest{Key: []byte{1}},
+	})
+	require.Error(t, err)
+
+	// active locks only take keys of the expiration queue
+	activeRes, err := f.queryServer.ActiveLocks(ctx, &types.QueryActiveLocksRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, activeRes.Locks, 2)
+	require.NotEmpty(t, activeRes.Pagination.NextKey)
+
+	_, err = f.queryServer.ActiveLocks(ctx, &types.QueryActiveLocksRequest{
+		Pagination: &query.PageRequest{Key: types.ParamsKey.Bytes()},
+	})
+	require.Error(t, err)
+}
+
+func lockDates(locks []types.LockResource) []string {
+	dates := make([]string, len(locks))
+	for i, lock := range locks {
+		dates[i] = lock.UnlockDate
+	}
+	return dates
+}
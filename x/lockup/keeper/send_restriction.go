@@ -36,23 +36,10 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 	// Determine how much of the sender's locked amount exceeds their
 	// delegated amount (i.e. the portion that must remain in the bank
 	// balance).
-	totalLocked, err := k.GetLockedAmountByAddress(sdkCtx, fromAddr)
+	totalLocked, totalDelegated, lockedAboveDelegated, err := k.GetUncoveredLockedAmount(sdkCtx, fromAddr)
 	if err != nil {
 		return toAddr, err
 	}
-	if totalLocked.IsZero() {
-		return toAddr, nil
-	}
-
-	totalDelegated, err := k.GetTotalDelegatedAmount(sdkCtx, fromAddr)
-	if err != nil {
-		return toAddr, err
-	}
-
-	lockedAboveDelegated := totalLocked.Sub(*totalDelegated)
-	if lockedAboveDelegated.IsNegative() {
-		lockedAboveDelegated = math.ZeroInt()
-	}
 
 	if lockedAboveDelegated.IsZero() {
 		return toAddr, nil
@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// Slashing policy
+//
+// Locks must always be covered by delegations. A slash burns delegated tokens
+// without the delegator doing anything, so the locks of an affected address
+// are reduced pro-rata until they are covered again by what is left
+// delegated. Addresses that delegate more than they lock absorb the slash
+// without any lock change. Lock schedules take part in the spread with their
+// currently locked amount. Every reduction emits a lock_slashed event per
+// unlock date or schedule and is added to the address's SlashedLocks record.
+
+// slashingKey is the context key used to mark the staking changes of a slash.
+type slashingKey struct{}
+
+// WithSlashing returns a context marking the staking changes made with it as
+// part of a slash. The staking hooks cannot refuse those changes, so locks no
+// longer covered by delegations are reduced instead.
+func WithSlashing(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(slashingKey{}, true)
+}
+
+// isSlashing reports whether the context was marked by WithSlashing.
+func isSlashing(ctx context.Context) bool {
+	slashing, _ := ctx.Value(slashingKey{}).(bool)
+	return slashing
+}
+
+// SlashingStakingKeeper is the staking keeper handed to the slashing module.
+// It marks the context of every slash with WithSlashing, so that the
+// redelegations and unbondings slashed along with the validator reduce the
+// locks of their delegators.
+type SlashingStakingKeeper struct {
+	*stakingkeeper.Keeper
+}
+
+// NewSlashingStakingKeeper wraps the staking keeper for the slashing module.
+func NewSlashingStakingKeeper(k *stakingkeeper.Keeper) SlashingStakingKeeper {
+	return SlashingStakingKeeper{Keeper: k}
+}
+
+// Slash slashes the validator in a context marked with WithSlashing.
+func (sk SlashingStakingKeeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	return sk.Keeper.Slash(WithSlashing(ctx), consAddr, infractionHeight, power, slashFactor)
+}
+
+// SlashWithInfractionReason slashes the validator in a context marked with
+// WithSlashing.
+func (sk SlashingStakingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction stakingtypes.Infraction) (math.Int, error) {
+	return sk.Keeper.SlashWithInfractionReason(WithSlashing(ctx), consAddr, infractionHeight, power, slashFactor, infraction)
+}
+
+// slashLocksForValidator reduces the locks of the delegators of the validator
+// that is about to lose the given fraction of its tokens. Only the
+// validator's own delegations are visited.
+func (k Keeper) slashLocksForValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction math.LegacyDec) error {
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.stakingKeeper.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		addr, err := sdk.AccAddressFromBech32(delegation.GetDelegatorAddr())
+		if err != nil {
+			return err
+		}
+
+		totalLocked, err := k.GetLockedAmountByAddress(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if totalLocked.IsZero() {
+			continue
+		}
+
+		totalDelegated, err := k.GetTotalDelegatedAmount(ctx, addr)
+		if err != nil {
+			return err
+		}
+
+		loss := validator.TokensFromShares(delegation.GetShares()).Mul(fraction).Ceil().TruncateInt()
+		delegatedAfter := totalDelegated.Sub(loss)
+
+		if delegatedAfter.LT(*totalLocked) {
+			reduction := totalLocked.Sub(math.MaxInt(delegatedAfter, math.ZeroInt()))
+			if err := k.reduceLocksForSlash(ctx, addr, reduction, valAddr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reduceLocksForSlash removes amount from the active locks and lock schedules
+// of addr, spread pro-rata over them. Truncation leftovers are taken from the
+// lock schedules first, then from the latest unlock dates.
+func (k Keeper) reduceLocksForSlash(ctx sdk.Context, addr sdk.AccAddress, amount math.Int, valAddr sdk.ValAddress) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime()
+
+	locks, err := k.GetLocksByAddress(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	schedules, err := k.GetLockSchedules(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	// slashable is an active lock or a lock schedule with a locked amount
+	type slashable struct {
+		lock     *types.Lock
+		schedule *types.LockSchedule
+		locked   math.Int
+	}
+
+	active := make([]slashable, 0, len(locks)+len(schedules))
+	totalLocked := math.ZeroInt()
+	for _, lock := range locks {
+		if types.IsLocked(blockTime, lock.UnlockDate) {
+			active = append(active, slashable{lock: lock, locked: lock.Amount})
+			totalLocked = totalLocked.Add(lock.Amount)
+		}
+	}
+	for i := range schedules {
+		locked := schedules[i].LockedAt(blockTime)
+		if locked.IsPositive() {
+			active = append(active, slashable{schedule: &schedules[i], locked: locked})
+			totalLocked = totalLocked.Add(locked)
+		}
+	}
+
+	if totalLocked.IsZero() {
+		return nil
+	}
+	if amount.GT(totalLocked) {
+		amount = totalLocked
+	}
+
+	cuts := make([]math.Int, len(active))
+	remainder := amount
+	for i, item := range active {
+		cuts[i] = item.locked.Mul(amount).Quo(totalLocked)
+		remainder = remainder.Sub(cuts[i])
+	}
+	for i := len(active) - 1; i >= 0 && remainder.IsPositive(); i-- {
+		extra := math.MinInt(remainder, active[i].locked.Sub(cuts[i]))
+		cuts[i] = cuts[i].Add(extra)
+		remainder = remainder.Sub(extra)
+	}
+
+	events := sdk.Events{}
+	for i, item := range active {
+		if !cuts[i].IsPositive() {
+			continue
+		}
+
+		if item.schedule != nil {
+			schedule := *item.schedule
+			schedule.Slashed = schedule.Slashed.Add(cuts[i])
+			if err := k.SetLockSchedule(ctx, addr, schedule); err != nil {
+				return err
+			}
+
+			endTime := schedule.Schedule.EndTime()
+			if err := k.LockupHooks().AfterLockRemoved(ctx, addr, endTime, cuts[i]); err != nil {
+				return err
+			}
+
+			if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+				Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+				UnlockDate: types.FormatUnlockTime(endTime),
+				Amount:     cuts[i],
+			}); err != nil {
+				return err
+			}
+
+			events = events.AppendEvent(sdk.NewEvent(
+				types.EventTypeLockSlashed,
+				sdk.NewAttribute(types.AttributeKeyLockAddress, addr.String()),
+				sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, cuts[i].String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			))
+			continue
+		}
+
+		lock := item.lock
+		unlockTime, err := types.ParseUnlockTime(lock.UnlockDate)
+		if err != nil {
+			return err
+		}
+
+		// the binding to the slashed validator goes first
+		remaining := lock.Amount.Sub(cuts[i])
+		if _, err := k.trimLockBindings(ctx, addr, unlockTime, remaining, valAddr); err != nil {
+			return err
+		}
+
+		if remaining.IsZero() {
+			err = k.RemoveLockByAddressAndDate(ctx, addr, lock.UnlockDate)
+		} else {
+			err = k.SetLockByAddress(ctx, addr, &types.Lock{UnlockDate: lock.UnlockDate, Amount: remaining})
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := k.RemoveFromExpirationQueue(ctx, unlockTime, addr, cuts[i]); err != nil {
+			return err
+		}
+
+		if err := k.LockupHooks().AfterLockRemoved(ctx, addr, unlockTime, cuts[i]); err != nil {
+			return err
+		}
+
+		if err := k.recordLockHistory(ctx, addr, types.LockHistoryEntry{
+			Change:     types.LOCK_HISTORY_TYPE_REMOVED,
+			UnlockDate: lock.UnlockDate,
+			Amount:     cuts[i],
+		}); err != nil {
+			return err
+		}
+
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeLockSlashed,
+			sdk.NewAttribute(types.AttributeKeyLockAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockDate, lock.UnlockDate),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, cuts[i].String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		))
+	}
+
+	slashed, err := k.GetSlashedLocks(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if err := k.SlashedLocks.Set(ctx, addr, slashed.Add(amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
+
+// GetSlashedLocks returns the lock amount removed from addr because of slashes
+func (k Keeper) GetSlashedLocks(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	amount, err := k.SlashedLocks.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return amount, err
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/keeper"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// setDelegations stores a bonded validator holding exactly the given
+// delegations, at one share per token.
+func setDelegations(t *testing.T, f *testFixture, valAddr sdk.ValAddress, delegations map[string]int64) {
+	t.Helper()
+
+	total := math.ZeroInt()
+	for delAddr, amount := range delegations {
+		require.NoError(t, f.stakingKeeper.SetDelegation(f.ctx, stakingtypes.NewDelegation(delAddr, valAddr.String(), math.LegacyNewDec(amount))))
+		total = total.AddRaw(amount)
+	}
+
+	require.NoError(t, f.stakingKeeper.SetValidator(f.ctx, stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          total,
+		DelegatorShares: math.LegacyNewDecFromInt(total),
+	}))
+}
+
+func TestBeforeValidatorSlashedReducesLocks(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+	valAddr := sdk.ValAddress(f.addrs[2])
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(400)},
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(600)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(400)},
+			{UnlockDate: "2026-01-01", Address: addr2, Amount: math.NewInt(100)},
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(600)},
+		},
+	}))
+
+	// addr1 delegates exactly its locks, addr2 delegates well above them
+	setDelegations(t, f, valAddr, map[string]int64{addr1: 1000, addr2: 1000})
+
+	require.NoError(t, f.k.Hooks().BeforeValidatorSlashed(f.ctx, valAddr, math.LegacyNewDecWithPrec(1, 1)))
+
+	locks, err := f.k.GetLocksByAddress(f.ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Len(t, locks, 2)
+	require.Equal(t, math.NewInt(360), locks[0].Amount)
+	require.Equal(t, math.NewInt(540), locks[1].Amount)
+
+	locks, err = f.k.GetLocksByAddress(f.ctx, f.addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), locks[0].Amount)
+
+	res, err := f.queryServer.SlashAdjustments(f.ctx, &types.QuerySlashAdjustmentsRequest{Address: addr1})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), res.SlashedAmount.Amount)
+
+	res, err = f.queryServer.SlashAdjustments(f.ctx, &types.QuerySlashAdjustmentsRequest{Address: addr2})
+	require.NoError(t, err)
+	require.True(t, res.SlashedAmount.Amount.IsZero())
+
+	total, err := f.k.GetTotalLocked(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), total)
+
+	_, broken := keeper.TotalLockedInvariant(f.k)(f.ctx)
+	require.False(t, broken)
+	_, broken = keeper.LocksIndexInvariant(f.k)(f.ctx)
+	require.False(t, broken)
+
+	var slashEvents int
+	for _, event := range f.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeLockSlashed {
+			slashEvents++
+		}
+	}
+	require.Equal(t, 2, slashEvents)
+
+	got := f.k.ExportGenesis(f.ctx)
+	require.NoError(t, got.Validate())
+	require.Equal(t, []types.SlashAdjustment{{Address: addr1, Amount: math.NewInt(100)}}, got.SlashAdjustments)
+}
+
+func TestBeforeValidatorSlashedReducesLockSchedules(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+	valAddr := sdk.ValAddress(f.addrs[2])
+
+	schedule := types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 2}
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(500)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(500)},
+		},
+		LockSchedules: []types.LockSchedule{
+			{Id: 0, Address: addr1, Schedule: schedule, Amount: math.NewInt(500), Slashed: math.ZeroInt()},
+			// addr2 only holds a schedule
+			{Id: 1, Address: addr2, Schedule: schedule, Amount: math.NewInt(1000), Slashed: math.ZeroInt()},
+		},
+		NextScheduleId: 2,
+	}))
+
+	setDelegations(t, f, valAddr, map[string]int64{addr1: 1000, addr2: 1000})
+
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, f.k.Hooks().BeforeValidatorSlashed(ctx, valAddr, math.LegacyNewDecWithPrec(1, 1)))
+
+	locks, err := f.k.GetLocksByAddress(ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(450), locks[0].Amount)
+
+	schedules, err := f.k.GetLockSchedules(ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), schedules[0].Slashed)
+
+	schedules, err = f.k.GetLockSchedules(ctx, f.addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), schedules[0].Slashed)
+
+	locked, err := f.k.GetLockedAmountByAddress(ctx, f.addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(900), *locked)
+
+	_, broken := keeper.AllInvariants(f.k)(ctx)
+	require.False(t, broken)
+
+	got := f.k.ExportGenesis(ctx)
+	require.NoError(t, got.Validate())
+	require.Len(t, got.LockSchedules, 2)
+	require.Equal(t, uint64(2), got.NextScheduleId)
+}
+
+func TestSlashingContextReducesLocks(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	valAddr := sdk.ValAddress(f.addrs[2])
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-06-01", Amount: math.NewInt(1000)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(1000)},
+		},
+	}))
+
+	setDelegations(t, f, valAddr, map[string]int64{addr1: 1000})
+
+	// contexts without a transaction, e.g. simulations and queries, are
+	// refused like transactions
+	cacheCtx, _ := f.ctx.CacheContext()
+	_, err := f.stakingKeeper.Unbond(cacheCtx, f.addrs[0], valAddr, math.LegacyNewDec(100))
+	require.ErrorIs(t, err, types.ErrInsufficientDelegations)
+
+	// a slash reduces the locks instead
+	_, err = f.stakingKeeper.Unbond(keeper.WithSlashing(f.ctx), f.addrs[0], valAddr, math.LegacyNewDec(100))
+	require.NoError(t, err)
+
+	locks, err := f.k.GetLocksByAddress(f.ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(900), locks[0].Amount)
+
+	slashed, err := f.k.GetSlashedLocks(f.ctx, f.addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), slashed)
+
+	_, broken := keeper.AllInvariants(f.k)(f.ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// unlockCalendar sums the amounts unlocking from from (inclusive) to to
+// (exclusive) per bucket. A zero to has no limit.
+type unlockCalendar struct {
+	bucket  string
+	from    time.Time
+	to      time.Time
+	amounts map[int64]math.Int
+}
+
+// newUnlockCalendar parses the bounds and bucket of an unlock calendar query.
+// Unlocks up to the current block time have already happened, so the
+// calendar never starts before it.
+func newUnlockCalendar(ctx sdk.Context, fromDate, toDate, bucket string) (*unlockCalendar, error) {
+	if bucket == "" {
+		bucket = types.UnlockBucketDay
+	}
+	if err := types.ValidateUnlockBucket(bucket); err != nil {
+		return nil, err
+	}
+
+	// IsLocked: a lock is still locked while the block time is before its
+	// unlock time.
+	from := ctx.BlockTime().Add(time.Nanosecond)
+	if fromDate != "" {
+		t, err := types.ParseUnlockTime(fromDate)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDate, "invalid from date format: %s", fromDate)
+		}
+		if t.After(from) {
+			from = t
+		}
+	}
+
+	var to time.Time
+	if toDate != "" {
+		t, err := types.ParseUnlockTime(toDate)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDate, "invalid to date format: %s", toDate)
+		}
+		to = t
+	}
+
+	return &unlockCalendar{
+		bucket:  bucket,
+		from:    from,
+		to:      to,
+		amounts: make(map[int64]math.Int),
+	}, nil
+}
+
+// add counts amount unlocking at unlockTime, if it is within the bounds
+func (c *unlockCalendar) add(unlockTime time.Time, amount math.Int) {
+	if unlockTime.Before(c.from) || (!c.to.IsZero() && !unlockTime.Before(c.to)) || !amount.IsPositive() {
+		return
+	}
+
+	start := types.UnlockBucketStart(c.bucket, unlockTime).Unix()
+	if sum, found := c.amounts[start]; found {
+		amount = amount.Add(sum)
+	}
+	c.amounts[start] = amount
+}
+
+// addSchedule counts the release steps of a lock schedule
+func (c *unlockCalendar) addSchedule(schedule types.LockSchedule) {
+	current := c.from.Add(-time.Nanosecond)
+	for {
+		releaseTime, amount, found := schedule.NextRelease(current)
+		if !found || (!c.to.IsZero() && !releaseTime.Before(c.to)) {
+			return
+		}
+
+		c.add(releaseTime, amount)
+		current = releaseTime
+	}
+}
+
+// buckets returns the buckets with unlocks in time order and their total
+func (c *unlockCalendar) buckets(bondDenom string) ([]types.UnlockScheduleBucket, sdk.Coin) {
+	starts := make([]int64, 0, len(c.amounts))
+	for start := range c.amounts {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	total := math.ZeroInt()
+	buckets := make([]types.UnlockScheduleBucket, 0, len(starts))
+	for _, start := range starts {
+		startTime := time.Unix(start, 0).UTC()
+		amount := c.amounts[start]
+
+		buckets = append(buckets, types.UnlockScheduleBucket{
+			StartDate: types.FormatUnlockTime(startTime),
+			EndDate:   types.FormatUnlockTime(types.UnlockBucketEnd(c.bucket, startTime)),
+			Amount:    sdk.NewCoin(bondDenom, amount),
+		})
+		total = total.Add(amount)
+	}
+
+	return buckets, sdk.NewCoin(bondDenom, total)
+}
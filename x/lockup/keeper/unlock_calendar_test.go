@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestUnlockSchedule(t *testing.T) {
+	f := SetupTest(t)
+
+	addr1 := f.addrs[0].String()
+	addr2 := f.addrs[1].String()
+	bondDenom := sdk.DefaultBondDenom
+
+	require.NoError(t, f.k.InitGenesis(f.ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		AccountLocks: []types.AccountLocks{
+			{Address: addr1, Locks: []*types.Lock{
+				{UnlockDate: "2026-01-01", Amount: math.NewInt(50)},
+				{UnlockDate: "2026-02-03", Amount: math.NewInt(100)},
+				{UnlockDate: "2026-02-05", Amount: math.NewInt(200)},
+			}},
+			{Address: addr2, Locks: []*types.Lock{
+				{UnlockDate: "2026-02-05", Amount: math.NewInt(300)},
+				{UnlockDate: "2026-03-01", Amount: math.NewInt(400)},
+			}},
+		},
+		ExpirationQueue: []types.ExpirationQueueEntry{
+			{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(50)},
+			{UnlockDate: "2026-02-03", Address: addr1, Amount: math.NewInt(100)},
+			{UnlockDate: "2026-02-05", Address: addr1, Amount: math.NewInt(200)},
+			{UnlockDate: "2026-02-05", Address: addr2, Amount: math.NewInt(300)},
+			{UnlockDate: "2026-03-01", Address: addr2, Amount: math.NewInt(400)},
+		},
+		LockSchedules: []types.LockSchedule{
+			{
+				Id:       0,
+				Address:  addr1,
+				Schedule: types.ReleaseSchedule{CliffDate: "2026-02-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 2},
+				Amount:   math.NewInt(1000),
+				Slashed:  math.ZeroInt(),
+			},
+		},
+		NextScheduleId: 1,
+	}))
+
+	// the lock of 2026-01-01 is already released
+	ctx := f.ctx.WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	res, err := f.queryServer.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Bucket: types.UnlockBucketMonth})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleBucket{
+		{StartDate: "2026-02-01", EndDate: "2026-03-01", Amount: sdk.NewInt64Coin(bondDenom, 1100)},
+		{StartDate: "2026-03-01", EndDate: "2026-04-01", Amount: sdk.NewInt64Coin(bondDenom, 900)},
+	}, res.Buckets)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 2000), res.Total)
+
+	res, err = f.queryServer.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{FromDate: "2026-02-02", ToDate: "2026-03-01"})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleBucket{
+		{StartDate: "2026-02-03", EndDate: "2026-02-04", Amount: sdk.NewInt64Coin(bondDenom, 100)},
+		{StartDate: "2026-02-05", EndDate: "2026-02-06", Amount: sdk.NewInt64Coin(bondDenom, 500)},
+	}, res.Buckets)
+
+	// only the schedules releasing before the to date are read
+	res, err = f.queryServer.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{ToDate: "2026-02-02"})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleBucket{
+		{StartDate: "2026-02-01", EndDate: "2026-02-02", Amount: sdk.NewInt64Coin(bondDenom, 500)},
+	}, res.Buckets)
+
+	byAddress, err := f.queryServer.UnlockScheduleByAddress(ctx, &types.QueryUnlockScheduleByAddressRequest{Address: addr1, Bucket: types.UnlockBucketWeek})
+	require.NoError(t, err)
+	require.Equal(t, []types.UnlockScheduleBucket{
+		{StartDate: "2026-01-26", EndDate: "2026-02-02", Amount: sdk.NewInt64Coin(bondDenom, 500)},
+		{StartDate: "2026-02-02", EndDate: "2026-02-09", Amount: sdk.NewInt64Coin(bondDenom, 300)},
+		{StartDate: "2026-02-23", EndDate: "2026-03-02", Amount: sdk.NewInt64Coin(bondDenom, 500)},
+	}, byAddress.Buckets)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1300), byAddress.Total)
+
+	_, err = f.queryServer.UnlockSchedule(ctx, &types.QueryUnlockScheduleRequest{Bucket: "year"})
+	require.Error(t, err)
+}
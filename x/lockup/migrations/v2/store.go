@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+// LegacyLocksByAddressKey is the v1 prefix under which all locks of an
+// address were stored as a single types.Locks blob.
+var LegacyLocksByAddressKey = []byte("locks_by_address")
+
+// MigrateStore performs in-place store migrations from v1 to v2. The v1
+// per-address types.Locks blobs are split into one LocksByAddress entry per
+// (address, unlock date) and then deleted.
+func MigrateStore(
+	ctx context.Context,
+	storeService storetypes.KVStoreService,
+	locksByAddress collections.Map[collections.Pair[sdk.AccAddress, time.Time], math.Int],
+) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), LegacyLocksByAddressKey)
+
+	iter := store.Iterator(nil, nil)
+
+	// Collect the legacy records first: the store must not be written to
+	// while the iterator is open.
+	var (
+		addrs []sdk.AccAddress
+		blobs [][]byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		addrs = append(addrs, sdk.AccAddress(iter.Key()))
+		blobs = append(blobs, iter.Value())
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, addr := range addrs {
+		locksList := &types.Locks{}
+		if err := locksList.Unmarshal(blobs[i]); err != nil {
+			return err
+		}
+
+		for _, lock := range locksList.Locks {
+			unlockTime, err := time.Parse(time.DateOnly, lock.UnlockDate)
+			if err != nil {
+				return err
+			}
+
+			key := collections.Join(addr, unlockTime)
+
+			// v1 allowed the same date twice in one blob; merge the amounts
+			amount, err := locksByAddress.Get(ctx, key)
+			switch {
+			case err == nil:
+				amount = amount.Add(lock.Amount)
+			case errors.Is(err, collections.ErrNotFound):
+				amount = lock.Amount
+			default:
+				return err
+			}
+
+			if err := locksByAddress.Set(ctx, key, amount); err != nil {
+				return err
+			}
+		}
+
+		store.Delete(addr)
+	}
+
+	return nil
+}
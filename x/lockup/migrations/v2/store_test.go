@@ -0,0 +1,72 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/TrustedSmartChain/tsc/v2/x/lockup/migrations/v2"
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	sb := collections.NewSchemaBuilder(storeService)
+	locksByAddress := collections.NewMap(
+		sb,
+		types.LocksByAddressKey,
+		"locks_by_address",
+		collections.PairKeyCodec(sdk.AccAddressKey, sdk.TimeKey),
+		sdk.IntValue,
+	)
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	legacy := map[string]types.Locks{
+		string(addr1): {Locks: []*types.Lock{
+			{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+			{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+		}},
+		string(addr2): {Locks: []*types.Lock{
+			{UnlockDate: "2026-01-01", Amount: math.NewInt(10)},
+		}},
+	}
+
+	store := ctx.KVStore(storeKey)
+	for addr, locks := range legacy {
+		bz, err := locks.Marshal()
+		require.NoError(t, err)
+		store.Set(append(append([]byte{}, v2.LegacyLocksByAddressKey...), addr...), bz)
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, locksByAddress))
+
+	for addr, locks := range legacy {
+		for _, lock := range locks.Locks {
+			unlockTime, err := time.Parse(time.DateOnly, lock.UnlockDate)
+			require.NoError(t, err)
+
+			amount, err := locksByAddress.Get(ctx, collections.Join(sdk.AccAddress(addr), unlockTime))
+			require.NoError(t, err)
+			require.Equal(t, lock.Amount, amount)
+		}
+	}
+
+	// the legacy blobs are gone
+	iter := storetypes.KVStorePrefixIterator(store, v2.LegacyLocksByAddressKey)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -11,6 +12,8 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	// "cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,7 +28,7 @@ import (
 
 const (
 	// ConsensusVersion defines the current x/lockup module consensus version.
-	ConsensusVersion = 1
+	ConsensusVersion = 3
 )
 
 var (
@@ -33,6 +36,8 @@ var (
 	_ module.AppModuleGenesis = AppModule{}
 	_ module.AppModule        = AppModule{}
 
+	_ appmodule.HasEndBlocker = AppModule{}
+
 	// _ autocli.HasAutoCLIConfig = AppModule{}
 )
 
@@ -63,7 +68,7 @@ func (a AppModuleBasic) Name() string {
 }
 
 func (a AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	return cdc.MustMarshalJSON(&types.GenesisState{})
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
 func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEncodingConfig, message json.RawMessage) error {
@@ -72,6 +77,9 @@ func (a AppModuleBasic) ValidateGenesis(marshaler codec.JSONCodec, _ client.TxEn
 	if err != nil {
 		return err
 	}
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrap(err, "lockup genesis")
+	}
 	return nil
 }
 
@@ -119,7 +127,13 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) jso
 	return marshaler.MustMarshalJSON(genState)
 }
 
-func (a AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+// EndBlock prunes the locks that expired up to the current block time.
+func (a AppModule) EndBlock(ctx context.Context) error {
+	return a.keeper.EndBlocker(ctx)
+}
+
+func (a AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, a.keeper)
 }
 
 func (a AppModule) QuerierRoute() string {
@@ -129,6 +143,15 @@ func (a AppModule) QuerierRoute() string {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // ConsensusVersion is a sequence number for state-breaking change of the
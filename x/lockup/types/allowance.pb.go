@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lockup/v1/allowance.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockAllowance lets spender lock and extend the locks of owner through the
+// lockup precompile, up to max_amount in total and until max_unlock_date.
+type LockAllowance struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	// max_amount is the amount spender can still lock or extend.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// max_unlock_date is the latest unlock date spender can lock or extend to.
+	MaxUnlockDate string `protobuf:"bytes,4,opt,name=max_unlock_date,json=maxUnlockDate,proto3" json:"max_unlock_date,omitempty"`
+}
+
+func (m *LockAllowance) Reset()         { *m = LockAllowance{} }
+func (m *LockAllowance) String() string { return proto.CompactTextString(m) }
+func (*LockAllowance) ProtoMessage()    {}
+func (*LockAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f606248046c87ff7, []int{0}
+}
+func (m *LockAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAllowance.Merge(m, src)
+}
+func (m *LockAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockAllowance proto.InternalMessageInfo
+
+func (m *LockAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *LockAllowance) GetMaxUnlockDate() string {
+	if m != nil {
+		return m.MaxUnlockDate
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*LockAllowance)(nil), "lockup.v1.LockAllowance")
+}
+
+func init() { proto.RegisterFile("lockup/v1/allowance.proto", fileDescriptor_f606248046c87ff7) }
+
+var fileDescriptor_f606248046c87ff7 = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x6b, 0xfa, 0x30,
+	0x18, 0xc7, 0x9b, 0xdf, 0x6f, 0x7f, 0x30, 0x20, 0x63, 0xc5, 0x41, 0xf5, 0x50, 0xc7, 0x0e, 0x63,
+	0x0c, 0x6c, 0xa6, 0x7b, 0x05, 0xba, 0x5d, 0x04, 0x61, 0xa0, 0xdb, 0x65, 0x17, 0x89, 0x6d, 0xa8,
+	0x45, 0x93, 0xa7, 0x24, 0xa9, 0x76, 0xef, 0x62, 0x2f, 0x63, 0xc7, 0x1d, 0x7c, 0x11, 0x1e, 0xc5,
+	0xd3, 0xd8, 0x41, 0x86, 0x1e, 0x76, 0xd9, 0x8b, 0x18, 0x6d, 0xea, 0x79, 0x97, 0x90, 0x7c, 0xff,
+	0x7c, 0x02, 0xcf, 0x83, 0xab, 0x53, 0xf0, 0x27, 0x49, 0x4c, 0x66, 0x4d, 0x42, 0xa7, 0x53, 0x98,
+	0x53, 0xe1, 0x33, 0x2f, 0x96, 0xa0, 0xc1, 0x2e, 0x19, 0xcb, 0x9b, 0x35, 0x6b, 0x95, 0x10, 0x42,
+	0xc8, 0x55, 0x92, 0xdd, 0x4c, 0xa0, 0x56, 0xf5, 0x41, 0x71, 0x50, 0x43, 0x63, 0x98, 0x47, 0x61,
+	0x9d, 0x52, 0x1e, 0x09, 0x20, 0xf9, 0x69, 0xa4, 0x8b, 0x1f, 0x84, 0xcb, 0x3d, 0xf0, 0x27, 0xed,
+	0xfd, 0x37, 0xb6, 0x87, 0x0f, 0x61, 0x2e, 0x98, 0x74, 0xd0, 0x39, 0xba, 0x2a, 0x75, 0x9c, 0xf5,
+	0xa2, 0x51, 0x29, 0x28, 0xed, 0x20, 0x90, 0x4c, 0xa9, 0x81, 0x96, 0x91, 0x08, 0xfb, 0x26, 0x66,
+	0xb7, 0xf0, 0xb1, 0x8a, 0x99, 0x08, 0x98, 0x74, 0xfe, 0xfd, 0xd1, 0xd8, 0x07, 0xed, 0x07, 0x8c,
+	0x39, 0x4d, 0x87, 0x94, 0x43, 0x22, 0xb4, 0xf3, 0x3f, 0xaf, 0xdd, 0x2c, 0x37, 0x75, 0xeb, 0x73,
+	0x53, 0x3f, 0x33, 0x55, 0x15, 0x4c, 0xbc, 0x08, 0x08, 0xa7, 0x7a, 0xec, 0x75, 0x85, 0x5e, 0x2f,
+	0x1a, 0xb8, 0x60, 0x76, 0x85, 0x7e, 0xfb, 0x7e, 0xbf, 0x46, 0xfd, 0x12, 0xa7, 0x69, 0x3b, 0x47,
+	0xd8, 0x97, 0xf8, 0x24, 0x03, 0x26, 0x22, 0x9b, 0xce, 0x30, 0xa0, 0x9a, 0x39, 0x07, 0x19, 0xb5,
+	0x5f, 0xe6, 0x34, 0x7d, 0xca, 0xd5, 0x7b, 0xaa, 0x59, 0xa7, 0xb7, 0xdc, 0xba, 0x68, 0xb5, 0x75,
+	0xd1, 0xd7, 0xd6, 0x45, 0xaf, 0x3b, 0xd7, 0x5a, 0xed, 0x5c, 0xeb, 0x63, 0xe7, 0x5a, 0xcf, 0xad,
+	0x30, 0xd2, 0xe3, 0x64, 0xe4, 0xf9, 0xc0, 0xc9, 0xa3, 0x4c, 0x94, 0x66, 0xc1, 0x80, 0x53, 0xa9,
+	0xef, 0xc6, 0x34, 0x12, 0x44, 0x2b, 0x9f, 0xcc, 0x5a, 0x24, 0x25, 0xc5, 0x62, 0xf4, 0x4b, 0xcc,
+	0xd4, 0xe8, 0x28, 0x9f, 0xe1, 0xed, 0xef, 0x00, 0x8c, 0x06, 0x36, 0x2a, 0xaf, 0x01, 0x00, 0x00,
+}
+
+func (m *LockAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxUnlockDate) > 0 {
+		i -= len(m.MaxUnlockDate)
+		copy(dAtA[i:], m.MaxUnlockDate)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.MaxUnlockDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAllowance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAllowance(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAllowance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAllowance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovAllowance(uint64(l))
+	l = len(m.MaxUnlockDate)
+	if l > 0 {
+		n += 1 + l + sovAllowance(uint64(l))
+	}
+	return n
+}
+
+func sovAllowance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAllowance(x uint64) (n int) {
+	return sovAllowance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxUnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAllowance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAllowance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAllowance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAllowance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAllowance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAllowance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAllowance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAllowance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAllowance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAllowance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAllowance = fmt.Errorf("proto: unexpected end of group")
+)
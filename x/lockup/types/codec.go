@@ -25,6 +25,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgExtend{}, ModuleName+"/MsgExtend", nil)
 	cdc.RegisterConcrete(&MsgSendDelegateAndLock{}, ModuleName+"/MsgSendDelegateAndLock", nil)
 	cdc.RegisterConcrete(&MsgMultiSendDelegateAndLock{}, ModuleName+"/MsgMultiSendDelegateAndLock", nil)
+	cdc.RegisterConcrete(&MsgUnlockEarly{}, ModuleName+"/MsgUnlockEarly", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, ModuleName+"/MsgTransferLock", nil)
+	cdc.RegisterConcrete(&MsgAcceptLockTransfers{}, ModuleName+"/MsgAcceptLockTransfers", nil)
+	cdc.RegisterConcrete(&MsgLockWithSchedule{}, ModuleName+"/MsgLockWithSchedule", nil)
+	cdc.RegisterConcrete(&MsgForceUnlock{}, ModuleName+"/MsgForceUnlock", nil)
+	cdc.RegisterConcrete(&MsgAdjustLock{}, ModuleName+"/MsgAdjustLock", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, ModuleName+"/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -35,6 +42,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgExtend{},
 		&MsgSendDelegateAndLock{},
 		&MsgMultiSendDelegateAndLock{},
+		&MsgUnlockEarly{},
+		&MsgTransferLock{},
+		&MsgAcceptLockTransfers{},
+		&MsgLockWithSchedule{},
+		&MsgForceUnlock{},
+		&MsgAdjustLock{},
+		&MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
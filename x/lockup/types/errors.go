@@ -5,10 +5,20 @@ import (
 )
 
 var (
-	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrInvalidAccount          = sdkerrors.Register(ModuleName, 1101, "invalid account type for lockup")
-	ErrInsufficientDelegations = sdkerrors.Register(ModuleName, 1102, "insufficient delegations amount to cover locked amount")
-	ErrLockupNotFound          = sdkerrors.Register(ModuleName, 1103, "lockup not found")
-	ErrInvalidDate             = sdkerrors.Register(ModuleName, 1104, "invalid date")
-	ErrInvalidAmount           = sdkerrors.Register(ModuleName, 1105, "invalid amount")
+	ErrInvalidSigner               = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidAccount              = sdkerrors.Register(ModuleName, 1101, "invalid account type for lockup")
+	ErrInsufficientDelegations     = sdkerrors.Register(ModuleName, 1102, "insufficient delegations amount to cover locked amount")
+	ErrLockupNotFound              = sdkerrors.Register(ModuleName, 1103, "lockup not found")
+	ErrInvalidDate                 = sdkerrors.Register(ModuleName, 1104, "invalid date")
+	ErrInvalidAmount               = sdkerrors.Register(ModuleName, 1105, "invalid amount")
+	ErrTooManyUnlockDates          = sdkerrors.Register(ModuleName, 1106, "too many distinct unlock dates")
+	ErrSendDelegateAndLockDisabled = sdkerrors.Register(ModuleName, 1107, "send delegate and lock is disabled")
+	ErrEarlyUnlockDisabled         = sdkerrors.Register(ModuleName, 1108, "early unlock is disabled")
+	ErrLockTransferDisabled        = sdkerrors.Register(ModuleName, 1109, "lock transfer is disabled")
+	ErrLockTransferNotAllowed      = sdkerrors.Register(ModuleName, 1110, "address is not allowed to transfer locks")
+	ErrInvalidSchedule             = sdkerrors.Register(ModuleName, 1111, "invalid release schedule")
+	ErrInsufficientLockAllowance   = sdkerrors.Register(ModuleName, 1112, "insufficient lock allowance")
+	ErrLockScheduleDisabled        = sdkerrors.Register(ModuleName, 1113, "lock with schedule is disabled")
+	ErrTooManyLockSchedules        = sdkerrors.Register(ModuleName, 1114, "too many lock schedules")
+	ErrLockTransferNotAccepted     = sdkerrors.Register(ModuleName, 1115, "lock transfer not accepted by the recipient")
 )
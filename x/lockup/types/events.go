@@ -1,12 +1,33 @@
 package types
 
 const (
-	EventTypeLock         = "lock"
-	EventTypeLockExtended = "lock_extended"
-	EventTypeLockExpired  = "lock_expired"
+	EventTypeLock                   = "lock"
+	EventTypeLockExtended           = "lock_extended"
+	EventTypeLockExpired            = "lock_expired"
+	EventTypeLockSlashed            = "lock_slashed"
+	EventTypeUnlockEarly            = "unlock_early"
+	EventTypeLockTransfer           = "lock_transfer"
+	EventTypeLockTransferAcceptance = "lock_transfer_acceptance"
+	EventTypeForceUnlock            = "force_unlock"
+	EventTypeLockAdjusted           = "lock_adjusted"
+	EventTypeLockSchedule           = "lock_schedule"
+	EventTypeLockScheduleReleased   = "lock_schedule_released"
 
 	AttributeKeyLockAddress   = "address"
 	AttributeKeyAmount        = "amount"
 	AttributeKeyUnlockDate    = "unlock_date"
 	AttributeKeyOldUnlockDate = "old_unlock_date"
+	AttributeKeyValidator     = "validator"
+	AttributeKeyPenalty       = "penalty"
+	AttributeKeyPenaltyBurned = "penalty_burned"
+	AttributeKeyRecipient     = "recipient"
+	AttributeKeySender        = "sender"
+	AttributeKeyAccepted      = "accepted"
+	AttributeKeyAuthority     = "authority"
+	AttributeKeyReason        = "reason"
+	AttributeKeyOldAmount     = "old_amount"
+	AttributeKeyScheduleID    = "schedule_id"
+	AttributeKeyCliffDate     = "cliff_date"
+	AttributeKeyReleaseType   = "release_type"
+	AttributeKeyPeriods       = "periods"
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
@@ -25,6 +26,7 @@ type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
@@ -33,12 +35,39 @@ type StakingKeeper interface {
 	GetValidator(ctx context.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, err error)
 	GetDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation stakingtypes.Delegation, err error)
 	GetDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress, maxRetrieve uint16) (delegations []stakingtypes.Delegation, err error)
+	GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) (delegations []stakingtypes.Delegation, err error)
 	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (newShares math.LegacyDec, err error)
+	Unbond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (amount math.Int, err error)
 	// Methods imported from staking should be defined here
 }
 
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
 	Set(context.Context, []byte, interface{})
 }
+
+// LockupHooks event hooks for lock changes. Every amount is the part of the
+// lock on unlockTime that changed, not the resulting lock amount. A lock
+// schedule is reported as a lock on the release time of its last step; the
+// steps the EndBlocker releases are reported as expired parts of it.
+type LockupHooks interface {
+	// AfterLockCreated is called when amount is locked until unlockTime,
+	// whether it opens a new lock or tops up an existing one.
+	AfterLockCreated(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error
+	// AfterLockExtended is called when amount is moved from the lock on
+	// fromTime to the lock on toTime.
+	AfterLockExtended(ctx context.Context, addr sdk.AccAddress, fromTime, toTime time.Time, amount math.Int) error
+	// AfterLockExpired is called by the EndBlocker when the lock on
+	// unlockTime is released at its unlock time.
+	AfterLockExpired(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error
+	// AfterLockRemoved is called when amount is removed from the lock on
+	// unlockTime before it expires: early unlocks, lock transfers,
+	// governance changes and slashes.
+	AfterLockRemoved(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error
+}
@@ -1,16 +1,269 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "params")
+	}
+
+	// address -> unlock date -> amount, built from the per-address records
+	byAddress := make(map[string]map[string]math.Int, len(gs.AccountLocks))
+
+	for _, accountLocks := range gs.AccountLocks {
+		if _, err := sdk.AccAddressFromBech32(accountLocks.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account locks address (%s)", err)
+		}
+
+		if _, found := byAddress[accountLocks.Address]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate account locks for address %s", accountLocks.Address)
+		}
+
+		dates := make(map[string]math.Int, len(accountLocks.Locks))
+		for _, lock := range accountLocks.Locks {
+			if lock == nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nil lock for address %s", accountLocks.Address)
+			}
+
+			unlockTime, err := ParseUnlockTime(lock.UnlockDate)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date %q for address %s", lock.UnlockDate, accountLocks.Address)
+			}
+			// Different forms of the same unlock time are the same lock.
+			unlockDate := FormatUnlockTime(unlockTime)
+
+			if lock.Amount.IsNil() || !lock.Amount.IsPositive() {
+				return errorsmod.Wrapf(ErrInvalidAmount, "lock amount must be positive for address %s on %s", accountLocks.Address, lock.UnlockDate)
+			}
+
+			if _, found := dates[unlockDate]; found {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate unlock date %s for address %s", lock.UnlockDate, accountLocks.Address)
+			}
+
+			dates[unlockDate] = lock.Amount
+		}
+
+		byAddress[accountLocks.Address] = dates
+	}
+
+	seen := make(map[string]map[string]struct{}, len(byAddress))
+	for _, entry := range gs.ExpirationQueue {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid expiration queue address (%s)", err)
+		}
+
+		unlockTime, err := ParseUnlockTime(entry.UnlockDate)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid expiration queue unlock date %q for address %s", entry.UnlockDate, entry.Address)
+		}
+		unlockDate := FormatUnlockTime(unlockTime)
+
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "expiration queue amount must be positive for address %s on %s", entry.Address, entry.UnlockDate)
+		}
+
+		if _, found := seen[entry.Address][unlockDate]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate expiration queue entry for address %s on %s", entry.Address, entry.UnlockDate)
+		}
+		if seen[entry.Address] == nil {
+			seen[entry.Address] = make(map[string]struct{})
+		}
+		seen[entry.Address][unlockDate] = struct{}{}
+
+		lockAmount, found := byAddress[entry.Address][unlockDate]
+		if !found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiration queue entry for address %s on %s has no matching lock", entry.Address, entry.UnlockDate)
+		}
+
+		if !lockAmount.Equal(entry.Amount) {
+			return errorsmod.Wrapf(ErrInvalidAmount, "expiration queue amount %s does not match lock amount %s for address %s on %s", entry.Amount, lockAmount, entry.Address, entry.UnlockDate)
+		}
+	}
+
+	for address, dates := range byAddress {
+		for date := range dates {
+			if _, found := seen[address][date]; !found {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock for address %s on %s has no matching expiration queue entry", address, date)
+			}
+		}
+	}
+
+	slashed := make(map[string]struct{}, len(gs.SlashAdjustments))
+	for _, adjustment := range gs.SlashAdjustments {
+		if _, err := sdk.AccAddressFromBech32(adjustment.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid slash adjustment address (%s)", err)
+		}
+
+		if adjustment.Amount.IsNil() || !adjustment.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "slash adjustment amount must be positive for address %s", adjustment.Address)
+		}
+
+		if _, found := slashed[adjustment.Address]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate slash adjustment for address %s", adjustment.Address)
+		}
+		slashed[adjustment.Address] = struct{}{}
+	}
+
+	scheduleIDs := make(map[uint64]struct{}, len(gs.LockSchedules))
+	for _, schedule := range gs.LockSchedules {
+		if _, err := sdk.AccAddressFromBech32(schedule.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock schedule address (%s)", err)
+		}
+
+		if _, found := scheduleIDs[schedule.Id]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock schedule id %d", schedule.Id)
+		}
+		scheduleIDs[schedule.Id] = struct{}{}
+
+		if schedule.Id >= gs.NextScheduleId {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock schedule id %d is not below the next schedule id %d", schedule.Id, gs.NextScheduleId)
+		}
+
+		if err := schedule.Schedule.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSchedule, "lock schedule %d: %s", schedule.Id, err)
+		}
+
+		if schedule.Amount.IsNil() || !schedule.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock schedule amount must be positive for schedule %d", schedule.Id)
+		}
+
+		if schedule.Slashed.IsNil() || schedule.Slashed.IsNegative() || schedule.Slashed.GT(schedule.Amount) {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock schedule slashed amount must be between zero and the amount for schedule %d", schedule.Id)
+		}
+	}
+
+	// address -> unlock date -> bound amount
+	bound := make(map[string]map[string]math.Int)
+	bindings := make(map[string]struct{}, len(gs.LockBindings))
+	for _, binding := range gs.LockBindings {
+		if _, err := sdk.AccAddressFromBech32(binding.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock binding address (%s)", err)
+		}
+
+		if _, err := sdk.ValAddressFromBech32(binding.ValidatorAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock binding validator address (%s)", err)
+		}
+
+		unlockTime, err := ParseUnlockTime(binding.UnlockDate)
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid lock binding unlock date %q for address %s", binding.UnlockDate, binding.Address)
+		}
+		unlockDate := FormatUnlockTime(unlockTime)
+
+		if binding.Amount.IsNil() || !binding.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock binding amount must be positive for address %s on %s", binding.Address, binding.UnlockDate)
+		}
+
+		key := binding.Address + "/" + unlockDate + "/" + binding.ValidatorAddress
+		if _, found := bindings[key]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock binding for address %s on %s to %s", binding.Address, binding.UnlockDate, binding.ValidatorAddress)
+		}
+		bindings[key] = struct{}{}
+
+		lockAmount, found := byAddress[binding.Address][unlockDate]
+		if !found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock binding for address %s on %s has no matching lock", binding.Address, binding.UnlockDate)
+		}
+
+		if bound[binding.Address] == nil {
+			bound[binding.Address] = make(map[string]math.Int)
+		}
+		total := binding.Amount
+		if prev, found := bound[binding.Address][unlockDate]; found {
+			total = total.Add(prev)
+		}
+		if total.GT(lockAmount) {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock bindings of %s exceed lock amount %s for address %s on %s", total, lockAmount, binding.Address, binding.UnlockDate)
+		}
+		bound[binding.Address][unlockDate] = total
+	}
+
+	historyIDs := make(map[uint64]struct{}, len(gs.LockHistory))
+	for _, entry := range gs.LockHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock history address (%s)", err)
+		}
+
+		if _, found := historyIDs[entry.Id]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock history id %d", entry.Id)
+		}
+		historyIDs[entry.Id] = struct{}{}
+
+		if entry.Id >= gs.NextHistoryId {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "lock history id %d is not below the next history id %d", entry.Id, gs.NextHistoryId)
+		}
+
+		if _, found := LockHistoryType_name[int32(entry.Change)]; !found || entry.Change == LOCK_HISTORY_TYPE_UNSPECIFIED {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid change %d for lock history entry %d", entry.Change, entry.Id)
+		}
+
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock history amount must be positive for entry %d", entry.Id)
+		}
+	}
+
+	allowances := make(map[string]struct{}, len(gs.LockAllowances))
+	for _, allowance := range gs.LockAllowances {
+		if _, err := sdk.AccAddressFromBech32(allowance.Owner); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock allowance owner (%s)", err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(allowance.Spender); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock allowance spender (%s)", err)
+		}
+
+		key := allowance.Owner + "/" + allowance.Spender
+		if _, found := allowances[key]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock allowance of %s for %s", allowance.Owner, allowance.Spender)
+		}
+		allowances[key] = struct{}{}
+
+		if allowance.MaxAmount.IsNil() || !allowance.MaxAmount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAmount, "lock allowance amount must be positive for %s of %s", allowance.Spender, allowance.Owner)
+		}
+
+		if _, err := ParseUnlockTime(allowance.MaxUnlockDate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid lock allowance unlock date %q for %s of %s", allowance.MaxUnlockDate, allowance.Spender, allowance.Owner)
+		}
+	}
+
+	acceptances := make(map[string]struct{}, len(gs.LockTransferAcceptances))
+	for _, acceptance := range gs.LockTransferAcceptances {
+		if _, err := sdk.AccAddressFromBech32(acceptance.Address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock transfer acceptance address (%s)", err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(acceptance.FromAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lock transfer acceptance from address (%s)", err)
+		}
+
+		if acceptance.Address == acceptance.FromAddress {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s cannot accept lock transfers from itself", acceptance.Address)
+		}
+
+		key := acceptance.Address + "/" + acceptance.FromAddress
+		if _, found := acceptances[key]; found {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate lock transfer acceptance of %s for %s", acceptance.Address, acceptance.FromAddress)
+		}
+		acceptances[key] = struct{}{}
+	}
 
 	return nil
 }
@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,6 +28,31 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the module genesis state
 type GenesisState struct {
+	// account_locks holds the per-address lock records.
+	AccountLocks []AccountLocks `protobuf:"bytes,1,rep,name=account_locks,json=accountLocks,proto3" json:"account_locks"`
+	// expiration_queue holds the locks_by_date expiration queue entries.
+	ExpirationQueue []ExpirationQueueEntry `protobuf:"bytes,2,rep,name=expiration_queue,json=expirationQueue,proto3" json:"expiration_queue"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// slash_adjustments holds the lock amounts removed from each address
+	// because of validator slashes.
+	SlashAdjustments []SlashAdjustment `protobuf:"bytes,4,rep,name=slash_adjustments,json=slashAdjustments,proto3" json:"slash_adjustments"`
+	// lock_schedules holds the locks released by schedule.
+	LockSchedules []LockSchedule `protobuf:"bytes,5,rep,name=lock_schedules,json=lockSchedules,proto3" json:"lock_schedules"`
+	// next_schedule_id is the id given to the next lock schedule.
+	NextScheduleId uint64 `protobuf:"varint,6,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
+	// lock_bindings holds the parts of locks bound to a validator.
+	LockBindings []LockBinding `protobuf:"bytes,7,rep,name=lock_bindings,json=lockBindings,proto3" json:"lock_bindings"`
+	// lock_history holds the recorded lock changes.
+	LockHistory []LockHistoryEntry `protobuf:"bytes,8,rep,name=lock_history,json=lockHistory,proto3" json:"lock_history"`
+	// next_history_id is the id given to the next lock history entry.
+	NextHistoryId uint64 `protobuf:"varint,9,opt,name=next_history_id,json=nextHistoryId,proto3" json:"next_history_id,omitempty"`
+	// lock_allowances holds the allowances to lock on behalf of another
+	// address.
+	LockAllowances []LockAllowance `protobuf:"bytes,10,rep,name=lock_allowances,json=lockAllowances,proto3" json:"lock_allowances"`
+	// lock_transfer_acceptances holds the senders each address accepts lock
+	// transfers from.
+	LockTransferAcceptances []LockTransferAcceptance `protobuf:"bytes,11,rep,name=lock_transfer_acceptances,json=lockTransferAcceptances,proto3" json:"lock_transfer_acceptances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -59,24 +88,293 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetAccountLocks() []AccountLocks {
+	if m != nil {
+		return m.AccountLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExpirationQueue() []ExpirationQueueEntry {
+	if m != nil {
+		return m.ExpirationQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSlashAdjustments() []SlashAdjustment {
+	if m != nil {
+		return m.SlashAdjustments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockSchedules() []LockSchedule {
+	if m != nil {
+		return m.LockSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLockBindings() []LockBinding {
+	if m != nil {
+		return m.LockBindings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockHistory() []LockHistoryEntry {
+	if m != nil {
+		return m.LockHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextHistoryId() uint64 {
+	if m != nil {
+		return m.NextHistoryId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLockAllowances() []LockAllowance {
+	if m != nil {
+		return m.LockAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockTransferAcceptances() []LockTransferAcceptance {
+	if m != nil {
+		return m.LockTransferAcceptances
+	}
+	return nil
+}
+
+// AccountLocks holds all locks recorded for a single address.
+type AccountLocks struct {
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Locks   []*Lock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (m *AccountLocks) Reset()         { *m = AccountLocks{} }
+func (m *AccountLocks) String() string { return proto.CompactTextString(m) }
+func (*AccountLocks) ProtoMessage()    {}
+func (*AccountLocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35a86100e05386ca, []int{1}
+}
+func (m *AccountLocks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLocks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLocks.Merge(m, src)
+}
+func (m *AccountLocks) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLocks proto.InternalMessageInfo
+
+func (m *AccountLocks) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountLocks) GetLocks() []*Lock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+// ExpirationQueueEntry is the amount an address has unlocking on a given date.
+type ExpirationQueueEntry struct {
+	UnlockDate string                `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	Address    string                `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *ExpirationQueueEntry) Reset()         { *m = ExpirationQueueEntry{} }
+func (m *ExpirationQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*ExpirationQueueEntry) ProtoMessage()    {}
+func (*ExpirationQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35a86100e05386ca, []int{2}
+}
+func (m *ExpirationQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationQueueEntry.Merge(m, src)
+}
+func (m *ExpirationQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationQueueEntry proto.InternalMessageInfo
+
+func (m *ExpirationQueueEntry) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *ExpirationQueueEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// SlashAdjustment is the total lock amount removed from an address because
+// its delegations were slashed.
+type SlashAdjustment struct {
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *SlashAdjustment) Reset()         { *m = SlashAdjustment{} }
+func (m *SlashAdjustment) String() string { return proto.CompactTextString(m) }
+func (*SlashAdjustment) ProtoMessage()    {}
+func (*SlashAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35a86100e05386ca, []int{3}
+}
+func (m *SlashAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashAdjustment.Merge(m, src)
+}
+func (m *SlashAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashAdjustment proto.InternalMessageInfo
+
+func (m *SlashAdjustment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lockup.v1.GenesisState")
+	proto.RegisterType((*AccountLocks)(nil), "lockup.v1.AccountLocks")
+	proto.RegisterType((*ExpirationQueueEntry)(nil), "lockup.v1.ExpirationQueueEntry")
+	proto.RegisterType((*SlashAdjustment)(nil), "lockup.v1.SlashAdjustment")
 }
 
 func init() { proto.RegisterFile("lockup/v1/genesis.proto", fileDescriptor_35a86100e05386ca) }
 
 var fileDescriptor_35a86100e05386ca = []byte{
-	// 145 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0xc9, 0x4f, 0xce,
-	0x2e, 0x2d, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x48, 0xe8, 0x95, 0x19, 0x2a, 0xf1, 0x71, 0xf1, 0xb8, 0x43,
-	0xe4, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x3f, 0xa4,
-	0xa8, 0xb4, 0xb8, 0x24, 0x35, 0x25, 0x38, 0x37, 0xb1, 0xa8, 0xc4, 0x39, 0x23, 0x31, 0x33, 0x4f,
-	0xbf, 0xa4, 0x38, 0x59, 0xbf, 0x42, 0x1f, 0x6a, 0x61, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x32, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x58, 0xcb, 0x9d, 0x87, 0x00, 0x00,
-	0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x18, 0x8d, 0xf9, 0x09, 0x37, 0x13, 0x20, 0x30, 0xca, 0x05, 0xc3, 0xd5, 0x4d, 0xb8, 0x91, 0x6e,
+	0x15, 0x55, 0xc2, 0x2e, 0xe9, 0x13, 0x24, 0x05, 0x41, 0x24, 0x2a, 0xd1, 0x84, 0x55, 0x37, 0xd6,
+	0x60, 0x4f, 0x93, 0x29, 0xf6, 0x4c, 0xea, 0x19, 0x53, 0x78, 0x8a, 0xf6, 0x31, 0xba, 0xec, 0x82,
+	0xbe, 0x03, 0x4b, 0xc4, 0xaa, 0xea, 0x02, 0x55, 0xb0, 0xe8, 0x03, 0xf4, 0x05, 0xaa, 0xf9, 0x71,
+	0xec, 0xa4, 0x2c, 0xaa, 0x76, 0x13, 0xd9, 0xe7, 0x9c, 0xef, 0x7c, 0x67, 0xbe, 0x7c, 0x63, 0xb0,
+	0x1e, 0x32, 0xff, 0x34, 0x19, 0xb9, 0x67, 0x3b, 0xee, 0x00, 0x53, 0xcc, 0x09, 0x77, 0x46, 0x31,
+	0x13, 0x0c, 0x96, 0x34, 0xe1, 0x9c, 0xed, 0x6c, 0x56, 0x07, 0x6c, 0xc0, 0x14, 0xea, 0xca, 0x27,
+	0x2d, 0xd8, 0xdc, 0xf0, 0x19, 0x8f, 0x18, 0xf7, 0x34, 0xa1, 0x5f, 0x0c, 0xb5, 0x8a, 0x22, 0x42,
+	0x99, 0xab, 0x7e, 0x53, 0x75, 0xd6, 0x07, 0x85, 0x21, 0x7b, 0x8b, 0xa8, 0x8f, 0x0d, 0x95, 0x8b,
+	0x30, 0x24, 0x5c, 0xb0, 0xf8, 0xc2, 0x10, 0xd5, 0x8c, 0x90, 0x4f, 0x06, 0xfd, 0x77, 0x12, 0xf5,
+	0x44, 0x8c, 0x28, 0x7f, 0x85, 0x63, 0x43, 0xaf, 0x65, 0xf4, 0x08, 0xc5, 0x28, 0x4a, 0x33, 0xd9,
+	0x19, 0xce, 0xfd, 0x21, 0x0e, 0x92, 0xd0, 0xf4, 0x6f, 0x7c, 0x9f, 0x07, 0x8b, 0xfb, 0xfa, 0xec,
+	0x7d, 0x81, 0x04, 0x86, 0x1d, 0xb0, 0x84, 0x7c, 0x9f, 0x25, 0x54, 0x78, 0xb2, 0x88, 0xdb, 0xd6,
+	0xd6, 0x6c, 0xb3, 0xdc, 0x5a, 0x77, 0xc6, 0x23, 0x71, 0xda, 0x9a, 0x3f, 0x94, 0x74, 0x67, 0xee,
+	0xea, 0xb6, 0x5e, 0xe8, 0x2d, 0xa2, 0x1c, 0x06, 0x8f, 0xc0, 0x0a, 0x3e, 0x1f, 0x91, 0x18, 0x09,
+	0xc2, 0xa8, 0xf7, 0x26, 0xc1, 0x09, 0xb6, 0x67, 0x94, 0x4d, 0x3d, 0x67, 0xb3, 0x37, 0x96, 0xbc,
+	0x90, 0x8a, 0x3d, 0x2a, 0xe2, 0x0b, 0x63, 0x57, 0xc1, 0x93, 0x1c, 0x74, 0x41, 0x51, 0x1f, 0xc8,
+	0x9e, 0xdd, 0xb2, 0x9a, 0xe5, 0xd6, 0x6a, 0xce, 0xe7, 0x48, 0x11, 0xa6, 0xd2, 0xc8, 0xe0, 0x73,
+	0xb0, 0xca, 0x43, 0xc4, 0x87, 0x1e, 0x0a, 0x5e, 0x27, 0x5c, 0x44, 0x98, 0x0a, 0x6e, 0xcf, 0xa9,
+	0x0c, 0x9b, 0xb9, 0xda, 0xbe, 0xd4, 0xb4, 0xc7, 0x12, 0x63, 0xb2, 0xc2, 0x27, 0x61, 0x0e, 0x77,
+	0xc1, 0xb2, 0x9a, 0x77, 0x3a, 0x3d, 0x6e, 0xcf, 0xff, 0x34, 0x16, 0x79, 0xf6, 0xbe, 0xe1, 0x8d,
+	0xd1, 0x52, 0x98, 0xc3, 0x38, 0x6c, 0x82, 0x15, 0x8a, 0xcf, 0xc5, 0xd8, 0xc5, 0x23, 0x81, 0x5d,
+	0xdc, 0xb2, 0x9a, 0x73, 0xbd, 0x65, 0x89, 0xa7, 0xc2, 0x6e, 0x00, 0xdb, 0x40, 0x95, 0x7a, 0x27,
+	0x84, 0x06, 0x84, 0x0e, 0xb8, 0xbd, 0xa0, 0xda, 0xad, 0x4d, 0xb5, 0xeb, 0x68, 0x3a, 0xfd, 0x13,
+	0xc2, 0x0c, 0x92, 0x91, 0xd5, 0xbb, 0x67, 0xd6, 0xca, 0xfe, 0x4b, 0x39, 0xfc, 0x33, 0xe5, 0x70,
+	0xa0, 0xd9, 0xfc, 0xf0, 0xcb, 0x61, 0x86, 0xc3, 0x47, 0xa0, 0xa2, 0x22, 0x1b, 0x17, 0x99, 0xb8,
+	0xa4, 0x12, 0x2f, 0x49, 0xd8, 0xa8, 0xba, 0x01, 0xdc, 0x07, 0x15, 0xd5, 0x6d, 0xbc, 0xdf, 0xdc,
+	0x06, 0xaa, 0xa1, 0x3d, 0xd5, 0xb0, 0x9d, 0x0a, 0x4c, 0xb7, 0xe5, 0x30, 0x0f, 0x72, 0xe8, 0x83,
+	0x8d, 0x89, 0xcd, 0xf6, 0x90, 0xef, 0xe3, 0x91, 0xd0, 0x96, 0x65, 0x65, 0xf9, 0xdf, 0x94, 0xe5,
+	0xb1, 0x91, 0xb6, 0xc7, 0x4a, 0xe3, 0xbd, 0x1e, 0x3e, 0xc8, 0xf2, 0x06, 0x01, 0x8b, 0xf9, 0x25,
+	0x86, 0x2d, 0xb0, 0x80, 0x82, 0x20, 0xc6, 0x5c, 0xae, 0xbb, 0xd5, 0x2c, 0x75, 0xec, 0x9b, 0xcb,
+	0xed, 0xaa, 0xb9, 0xd6, 0x6d, 0xcd, 0xf4, 0x45, 0x4c, 0xe8, 0xa0, 0x97, 0x0a, 0xe1, 0xff, 0x60,
+	0x5e, 0x5f, 0x10, 0xbd, 0xd9, 0x95, 0xa9, 0x50, 0x3d, 0xcd, 0x36, 0x3e, 0x59, 0xa0, 0xfa, 0xd0,
+	0xa6, 0xc3, 0x3a, 0x28, 0x27, 0x54, 0x1d, 0x35, 0x40, 0x02, 0xeb, 0xbe, 0x3d, 0xa0, 0xa1, 0x5d,
+	0x79, 0x13, 0x73, 0xa1, 0x66, 0x7e, 0x35, 0xd4, 0x01, 0x28, 0xa2, 0x48, 0x9e, 0x4b, 0xdd, 0x93,
+	0x52, 0xe7, 0x89, 0x9c, 0xc3, 0x97, 0xdb, 0xfa, 0xdf, 0xba, 0x8c, 0x07, 0xa7, 0x0e, 0x61, 0x6e,
+	0x84, 0xc4, 0xd0, 0xe9, 0x52, 0x71, 0x73, 0xb9, 0x0d, 0x8c, 0x5f, 0x97, 0x8a, 0x0f, 0xdf, 0x3e,
+	0x3e, 0xb6, 0x7a, 0xa6, 0xbe, 0xf1, 0xce, 0x02, 0x95, 0xa9, 0xdb, 0xf1, 0x5b, 0x63, 0xca, 0x12,
+	0xcd, 0xfc, 0x59, 0xa2, 0xce, 0xe1, 0xd5, 0x5d, 0xcd, 0xba, 0xbe, 0xab, 0x59, 0x5f, 0xef, 0x6a,
+	0xd6, 0xfb, 0xfb, 0x5a, 0xe1, 0xfa, 0xbe, 0x56, 0xf8, 0x7c, 0x5f, 0x2b, 0xbc, 0x6c, 0x0d, 0x88,
+	0x18, 0x26, 0x27, 0x8e, 0xcf, 0x22, 0xf7, 0x38, 0x4e, 0xb8, 0xc0, 0x41, 0x3f, 0x42, 0xb1, 0x78,
+	0x36, 0x44, 0x84, 0xba, 0x82, 0xfb, 0xee, 0x59, 0xcb, 0x3d, 0x77, 0xcd, 0x47, 0x50, 0x5c, 0x8c,
+	0x30, 0x3f, 0x29, 0xaa, 0xef, 0xdf, 0xd3, 0x1f, 0x03, 0x00, 0x29, 0xf8, 0x03, 0x6f, 0x04, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -99,6 +397,269 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockTransferAcceptances) > 0 {
+		for iNdEx := len(m.LockTransferAcceptances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockTransferAcceptances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.LockAllowances) > 0 {
+		for iNdEx := len(m.LockAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.NextHistoryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHistoryId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.LockHistory) > 0 {
+		for iNdEx := len(m.LockHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LockBindings) > 0 {
+		for iNdEx := len(m.LockBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LockSchedules) > 0 {
+		for iNdEx := len(m.LockSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SlashAdjustments) > 0 {
+		for iNdEx := len(m.SlashAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ExpirationQueue) > 0 {
+		for iNdEx := len(m.ExpirationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpirationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AccountLocks) > 0 {
+		for iNdEx := len(m.AccountLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountLocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLocks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLocks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpirationQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -119,18 +680,127 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AccountLocks) > 0 {
+		for _, e := range m.AccountLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExpirationQueue) > 0 {
+		for _, e := range m.ExpirationQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashAdjustments) > 0 {
+		for _, e := range m.SlashAdjustments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockSchedules) > 0 {
+		for _, e := range m.LockSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
+	if len(m.LockBindings) > 0 {
+		for _, e := range m.LockBindings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockHistory) > 0 {
+		for _, e := range m.LockHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextHistoryId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextHistoryId))
+	}
+	if len(m.LockAllowances) > 0 {
+		for _, e := range m.LockAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockTransferAcceptances) > 0 {
+		for _, e := range m.LockTransferAcceptances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *AccountLocks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExpirationQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SlashAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -157,6 +827,729 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountLocks = append(m.AccountLocks, AccountLocks{})
+			if err := m.AccountLocks[len(m.AccountLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpirationQueue = append(m.ExpirationQueue, ExpirationQueueEntry{})
+			if err := m.ExpirationQueue[len(m.ExpirationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAdjustments = append(m.SlashAdjustments, SlashAdjustment{})
+			if err := m.SlashAdjustments[len(m.SlashAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockSchedules = append(m.LockSchedules, LockSchedule{})
+			if err := m.LockSchedules[len(m.LockSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockBindings = append(m.LockBindings, LockBinding{})
+			if err := m.LockBindings[len(m.LockBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockHistory = append(m.LockHistory, LockHistoryEntry{})
+			if err := m.LockHistory[len(m.LockHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHistoryId", wireType)
+			}
+			m.NextHistoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHistoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockAllowances = append(m.LockAllowances, LockAllowance{})
+			if err := m.LockAllowances[len(m.LockAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTransferAcceptances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTransferAcceptances = append(m.LockTransferAcceptances, LockTransferAcceptance{})
+			if err := m.LockTransferAcceptances[len(m.LockTransferAcceptances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountLocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, &Lock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpirationQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,24 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+
+	expensiveParams := types.DefaultParams()
+	expensiveParams.PrecompileGas.PerExtensionGas = types.MaxPrecompileGas + 1
+
+	unboundedSchedulesParams := types.DefaultParams()
+	unboundedSchedulesParams.MaxLockSchedules = 0
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,9 +33,187 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
+			genState: &types.GenesisState{Params: types.DefaultParams()},
 			valid:    true,
 		},
+		{
+			desc: "valid genesis state with locks",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{
+						{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+						{UnlockDate: "2026-06-01", Amount: math.NewInt(50)},
+					}},
+					{Address: addr2, Locks: []*types.Lock{
+						{UnlockDate: "2026-01-01", Amount: math.NewInt(10)},
+					}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+					{UnlockDate: "2026-01-01", Address: addr2, Amount: math.NewInt(10)},
+					{UnlockDate: "2026-06-01", Address: addr1, Amount: math.NewInt(50)},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc:     "missing params",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "invalid address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: "invalid", Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.NewInt(100)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate address",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.NewInt(100)}}},
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-02-01", Amount: math.NewInt(100)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+					{UnlockDate: "2026-02-01", Address: addr1, Amount: math.NewInt(100)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate unlock date",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{
+						{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+						{UnlockDate: "2026-01-01", Amount: math.NewInt(100)},
+					}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(200)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid unlock date",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "01/01/2026", Amount: math.NewInt(100)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-positive amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.ZeroInt()}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.ZeroInt()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "lock without queue entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.NewInt(100)}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "queue entry without lock",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "queue amount mismatch",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.NewInt(100)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(99)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate queue entry",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AccountLocks: []types.AccountLocks{
+					{Address: addr1, Locks: []*types.Lock{{UnlockDate: "2026-01-01", Amount: math.NewInt(100)}}},
+				},
+				ExpirationQueue: []types.ExpirationQueueEntry{
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+					{UnlockDate: "2026-01-01", Address: addr1, Amount: math.NewInt(100)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid slash adjustment",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashAdjustments: []types.SlashAdjustment{
+					{Address: addr1, Amount: math.NewInt(10)},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate slash adjustment",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashAdjustments: []types.SlashAdjustment{
+					{Address: addr1, Amount: math.NewInt(10)},
+					{Address: addr1, Amount: math.NewInt(5)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero slash adjustment",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				SlashAdjustments: []types.SlashAdjustment{
+					{Address: addr1, Amount: math.ZeroInt()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc:     "precompile gas above max",
+			genState: &types.GenesisState{Params: expensiveParams},
+			valid:    false,
+		},
+		{
+			desc:     "no lock schedule bound",
+			genState: &types.GenesisState{Params: unboundedSchedulesParams},
+			valid:    false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
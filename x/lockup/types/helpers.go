@@ -1,12 +1,67 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
 
-func IsLocked(currentDate time.Time, unlockDate string) bool {
-	unlockTime, err := time.Parse(time.DateOnly, unlockDate)
+// MinUnixUnlockSeconds is the smallest number of Unix seconds accepted as an
+// unlock time (2001-09-09). Smaller numbers are more likely a mistyped date,
+// such as 20260102, than a time in 1970.
+const MinUnixUnlockSeconds int64 = 1_000_000_000
+
+// ParseUnlockTime parses the unlock time of a lock. It accepts a date
+// (2006-01-02), which unlocks at midnight UTC, an RFC3339 timestamp or a
+// number of Unix seconds of at least MinUnixUnlockSeconds. Unlock times are
+// kept with second precision.
+func ParseUnlockTime(unlockDate string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, unlockDate); err == nil {
+		return t, nil
+	}
+
+	if seconds, err := strconv.ParseInt(unlockDate, 10, 64); err == nil {
+		if seconds < MinUnixUnlockSeconds {
+			return time.Time{}, fmt.Errorf("unlock time in Unix seconds must be at least %d, dates are written 2006-01-02: %s", MinUnixUnlockSeconds, unlockDate)
+		}
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, unlockDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unlock time must be a date, an RFC3339 timestamp or Unix seconds: %s", unlockDate)
+	}
+
+	if t.Nanosecond() != 0 {
+		return time.Time{}, fmt.Errorf("unlock time cannot have fractional seconds: %s", unlockDate)
+	}
+
+	if t.Unix() < 0 {
+		return time.Time{}, fmt.Errorf("unlock time cannot be before the Unix epoch: %s", unlockDate)
+	}
+
+	return t.UTC(), nil
+}
+
+// FormatUnlockTime returns the canonical form of an unlock time: a date for
+// midnight UTC, so that day-granular locks keep their original form, and an
+// RFC3339 timestamp in UTC otherwise.
+func FormatUnlockTime(unlockTime time.Time) string {
+	unlockTime = unlockTime.UTC()
+	if unlockTime.Equal(unlockTime.Truncate(24 * time.Hour)) {
+		return unlockTime.Format(time.DateOnly)
+	}
+
+	return unlockTime.Format(time.RFC3339)
+}
+
+// IsLocked reports whether a lock with the given unlock time is still locked
+// at currentTime.
+func IsLocked(currentTime time.Time, unlockDate string) bool {
+	unlockTime, err := ParseUnlockTime(unlockDate)
 	if err != nil {
 		return false
 	}
 
-	return currentDate.Before(unlockTime)
+	return currentTime.Before(unlockTime)
 }
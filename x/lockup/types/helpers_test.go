@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestParseUnlockTime(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  time.Time
+		canonical string
+		valid     bool
+	}{
+		{input: "2026-01-02", expected: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), canonical: "2026-01-02", valid: true},
+		{input: "2026-01-02T00:00:00Z", expected: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), canonical: "2026-01-02", valid: true},
+		{input: "2026-01-02T15:04:05Z", expected: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), canonical: "2026-01-02T15:04:05Z", valid: true},
+		{input: "2026-01-02T17:04:05+02:00", expected: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), canonical: "2026-01-02T15:04:05Z", valid: true},
+		{input: "1767366245", expected: time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), canonical: "2026-01-02T15:04:05Z", valid: true},
+		{input: "2026-01-02T15:04:05.5Z", valid: false},
+		{input: "-1", valid: false},
+		{input: "20260102", valid: false},
+		{input: "999999999", valid: false},
+		{input: "1000000000", expected: time.Date(2001, 9, 9, 1, 46, 40, 0, time.UTC), canonical: "2001-09-09T01:46:40Z", valid: true},
+		{input: "01/02/2026", valid: false},
+		{input: "", valid: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := types.ParseUnlockTime(tc.input)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.expected.Equal(got))
+			require.Equal(t, tc.canonical, types.FormatUnlockTime(got))
+		})
+	}
+}
@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lockup/v1/history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LockHistoryType is the kind of lock change recorded in the lock history.
+type LockHistoryType int32
+
+const (
+	LOCK_HISTORY_TYPE_UNSPECIFIED LockHistoryType = 0
+	// LOCK_HISTORY_TYPE_CREATED records a new lock or an increase of a lock.
+	LOCK_HISTORY_TYPE_CREATED LockHistoryType = 1
+	// LOCK_HISTORY_TYPE_EXTENDED records an amount moved from old_unlock_date
+	// to unlock_date.
+	LOCK_HISTORY_TYPE_EXTENDED LockHistoryType = 2
+	// LOCK_HISTORY_TYPE_EXPIRED records a lock released at its unlock date.
+	LOCK_HISTORY_TYPE_EXPIRED LockHistoryType = 3
+	// LOCK_HISTORY_TYPE_TRANSFERRED_OUT records a lock moved to counterparty.
+	LOCK_HISTORY_TYPE_TRANSFERRED_OUT LockHistoryType = 4
+	// LOCK_HISTORY_TYPE_TRANSFERRED_IN records a lock received from
+	// counterparty.
+	LOCK_HISTORY_TYPE_TRANSFERRED_IN LockHistoryType = 5
+	// LOCK_HISTORY_TYPE_REMOVED records a lock removed before its unlock date:
+	// early unlocks, governance decreases and slashes.
+	LOCK_HISTORY_TYPE_REMOVED LockHistoryType = 6
+)
+
+var LockHistoryType_name = map[int32]string{
+	0: "LOCK_HISTORY_TYPE_UNSPECIFIED",
+	1: "LOCK_HISTORY_TYPE_CREATED",
+	2: "LOCK_HISTORY_TYPE_EXTENDED",
+	3: "LOCK_HISTORY_TYPE_EXPIRED",
+	4: "LOCK_HISTORY_TYPE_TRANSFERRED_OUT",
+	5: "LOCK_HISTORY_TYPE_TRANSFERRED_IN",
+	6: "LOCK_HISTORY_TYPE_REMOVED",
+}
+
+var LockHistoryType_value = map[string]int32{
+	"LOCK_HISTORY_TYPE_UNSPECIFIED":     0,
+	"LOCK_HISTORY_TYPE_CREATED":         1,
+	"LOCK_HISTORY_TYPE_EXTENDED":        2,
+	"LOCK_HISTORY_TYPE_EXPIRED":         3,
+	"LOCK_HISTORY_TYPE_TRANSFERRED_OUT": 4,
+	"LOCK_HISTORY_TYPE_TRANSFERRED_IN":  5,
+	"LOCK_HISTORY_TYPE_REMOVED":         6,
+}
+
+func (x LockHistoryType) String() string {
+	return proto.EnumName(LockHistoryType_name, int32(x))
+}
+
+func (LockHistoryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c0457e7c15b7e8e, []int{0}
+}
+
+// LockHistoryEntry is a change to the locks of address.
+type LockHistoryEntry struct {
+	Id         uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address    string          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Change     LockHistoryType `protobuf:"varint,3,opt,name=change,proto3,enum=lockup.v1.LockHistoryType" json:"change,omitempty"`
+	UnlockDate string          `protobuf:"bytes,4,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	// old_unlock_date is the unlock date the amount was extended from.
+	OldUnlockDate string                `protobuf:"bytes,5,opt,name=old_unlock_date,json=oldUnlockDate,proto3" json:"old_unlock_date,omitempty"`
+	Amount        cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// counterparty is the other address of a transfer.
+	Counterparty string    `protobuf:"bytes,7,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	BlockHeight  int64     `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime    time.Time `protobuf:"bytes,9,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// tx_hash is the hash of the transaction that made the change, empty for
+	// changes made by the chain itself such as expiries.
+	TxHash string `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *LockHistoryEntry) Reset()         { *m = LockHistoryEntry{} }
+func (m *LockHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*LockHistoryEntry) ProtoMessage()    {}
+func (*LockHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c0457e7c15b7e8e, []int{0}
+}
+func (m *LockHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockHistoryEntry.Merge(m, src)
+}
+func (m *LockHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockHistoryEntry proto.InternalMessageInfo
+
+func (m *LockHistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LockHistoryEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetChange() LockHistoryType {
+	if m != nil {
+		return m.Change
+	}
+	return LOCK_HISTORY_TYPE_UNSPECIFIED
+}
+
+func (m *LockHistoryEntry) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetOldUnlockDate() string {
+	if m != nil {
+		return m.OldUnlockDate
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
+func (m *LockHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *LockHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *LockHistoryEntry) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("lockup.v1.LockHistoryType", LockHistoryType_name, LockHistoryType_value)
+	proto.RegisterType((*LockHistoryEntry)(nil), "lockup.v1.LockHistoryEntry")
+}
+
+func init() { proto.RegisterFile("lockup/v1/history.proto", fileDescriptor_1c0457e7c15b7e8e) }
+
+var fileDescriptor_1c0457e7c15b7e8e = []byte{
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x4e, 0xdb, 0x30,
+	0x1c, 0xc6, 0x9b, 0xb6, 0x14, 0x6a, 0x18, 0x64, 0x16, 0x13, 0xa1, 0x12, 0x69, 0x41, 0xdb, 0x54,
+	0x21, 0x91, 0x8c, 0xee, 0x09, 0xa0, 0x31, 0x6a, 0x34, 0xd6, 0x22, 0x37, 0x4c, 0xb0, 0x4b, 0xe4,
+	0x26, 0x59, 0x12, 0xd1, 0xc4, 0x55, 0xec, 0x22, 0x7a, 0xdf, 0x61, 0x47, 0xde, 0x61, 0x97, 0x1d,
+	0x77, 0xe0, 0x09, 0x76, 0xe2, 0x88, 0x38, 0x4d, 0x3b, 0xb0, 0x09, 0x0e, 0x7b, 0x8d, 0x29, 0x4e,
+	0xd8, 0xd8, 0x40, 0xbb, 0x44, 0xf6, 0xf7, 0xfd, 0xac, 0xef, 0xb3, 0xff, 0x0a, 0x58, 0x1a, 0x52,
+	0xe7, 0x68, 0x3c, 0xd2, 0x8f, 0x37, 0xf5, 0x20, 0x64, 0x9c, 0x26, 0x13, 0x6d, 0x94, 0x50, 0x4e,
+	0x61, 0x35, 0x33, 0xb4, 0xe3, 0xcd, 0xda, 0xa2, 0x4f, 0x7d, 0x2a, 0x54, 0x3d, 0x5d, 0x65, 0x40,
+	0x6d, 0xd9, 0xa1, 0x2c, 0xa2, 0xcc, 0xce, 0x8c, 0x6c, 0x93, 0x5b, 0x8f, 0x49, 0x14, 0xc6, 0x54,
+	0x17, 0xdf, 0x5c, 0xaa, 0xfb, 0x94, 0xfa, 0x43, 0x4f, 0x17, 0xbb, 0xc1, 0xf8, 0x9d, 0xce, 0xc3,
+	0xc8, 0x63, 0x9c, 0x44, 0xa3, 0x0c, 0x58, 0xfb, 0x52, 0x02, 0xf2, 0x2e, 0x75, 0x8e, 0x3a, 0x59,
+	0x0b, 0x14, 0xf3, 0x64, 0x02, 0xe7, 0x41, 0x31, 0x74, 0x15, 0xa9, 0x21, 0x35, 0xcb, 0xb8, 0x18,
+	0xba, 0xb0, 0x05, 0xa6, 0x89, 0xeb, 0x26, 0x1e, 0x63, 0x4a, 0xb1, 0x21, 0x35, 0xab, 0xdb, 0xca,
+	0xe5, 0xd9, 0xc6, 0x62, 0x9e, 0xbd, 0x95, 0x39, 0x7d, 0x9e, 0x84, 0xb1, 0x8f, 0x6f, 0x41, 0xd8,
+	0x02, 0x15, 0x27, 0x20, 0xb1, 0xef, 0x29, 0xa5, 0x86, 0xd4, 0x9c, 0x6f, 0xd5, 0xb4, 0xdf, 0x37,
+	0xd3, 0xee, 0x04, 0x5a, 0x93, 0x91, 0x87, 0x73, 0x12, 0xd6, 0xc1, 0xec, 0x38, 0x4e, 0x31, 0xdb,
+	0x25, 0xdc, 0x53, 0xca, 0x69, 0x16, 0x06, 0x99, 0x64, 0x10, 0xee, 0xc1, 0xe7, 0x60, 0x81, 0x0e,
+	0x5d, 0xfb, 0x2e, 0x34, 0x25, 0xa0, 0x47, 0x74, 0xe8, 0xee, 0xff, 0xe1, 0x3a, 0xa0, 0x42, 0x22,
+	0x3a, 0x8e, 0xb9, 0x52, 0x11, 0x7d, 0x5f, 0x9c, 0x5f, 0xd5, 0x0b, 0xdf, 0xae, 0xea, 0x4f, 0xb2,
+	0xce, 0xcc, 0x3d, 0xd2, 0x42, 0xaa, 0x47, 0x84, 0x07, 0x9a, 0x19, 0xf3, 0xcb, 0xb3, 0x0d, 0x90,
+	0x5f, 0xc6, 0x8c, 0xf9, 0xa7, 0x9f, 0x9f, 0xd7, 0x25, 0x9c, 0x9f, 0x87, 0x6b, 0x60, 0xce, 0x49,
+	0x17, 0x5e, 0x32, 0x22, 0x09, 0x9f, 0x28, 0xd3, 0x22, 0xee, 0x2f, 0x0d, 0xae, 0x82, 0xb9, 0x81,
+	0x28, 0x14, 0x78, 0xa1, 0x1f, 0x70, 0x65, 0xa6, 0x21, 0x35, 0x4b, 0x78, 0x56, 0x68, 0x1d, 0x21,
+	0xc1, 0x36, 0x00, 0x19, 0x92, 0xbe, 0xbf, 0x52, 0x6d, 0x48, 0xcd, 0xd9, 0x56, 0x4d, 0xcb, 0x86,
+	0xa3, 0xdd, 0x0e, 0x47, 0xb3, 0x6e, 0x87, 0xb3, 0x3d, 0x93, 0x16, 0x3e, 0xfd, 0x5e, 0x97, 0x70,
+	0x55, 0x9c, 0x4b, 0x1d, 0xb8, 0x04, 0xa6, 0xf9, 0x89, 0x1d, 0x10, 0x16, 0x28, 0x40, 0xd4, 0xa8,
+	0xf0, 0x93, 0x0e, 0x61, 0xc1, 0xfa, 0xfb, 0x22, 0x58, 0xf8, 0xe7, 0x4d, 0xe1, 0x2a, 0x58, 0xd9,
+	0xed, 0xb5, 0x5f, 0xd9, 0x1d, 0xb3, 0x6f, 0xf5, 0xf0, 0xa1, 0x6d, 0x1d, 0xee, 0x21, 0x7b, 0xbf,
+	0xdb, 0xdf, 0x43, 0x6d, 0x73, 0xc7, 0x44, 0x86, 0x5c, 0x80, 0x2b, 0x60, 0xf9, 0x3e, 0xd2, 0xc6,
+	0x68, 0xcb, 0x42, 0x86, 0x2c, 0x41, 0x15, 0xd4, 0xee, 0xdb, 0xe8, 0xc0, 0x42, 0x5d, 0x03, 0x19,
+	0x72, 0xf1, 0xe1, 0xe3, 0xe8, 0x60, 0xcf, 0xc4, 0xc8, 0x90, 0x4b, 0xf0, 0x19, 0x58, 0xbd, 0x6f,
+	0x5b, 0x78, 0xab, 0xdb, 0xdf, 0x41, 0x18, 0x23, 0xc3, 0xee, 0xed, 0x5b, 0x72, 0x19, 0x3e, 0x05,
+	0x8d, 0xff, 0x63, 0x66, 0x57, 0x9e, 0x7a, 0x38, 0x0b, 0xa3, 0xd7, 0xbd, 0x37, 0xc8, 0x90, 0x2b,
+	0xb5, 0xf2, 0x87, 0x8f, 0x6a, 0x61, 0x7b, 0xf7, 0xfc, 0x5a, 0x95, 0x2e, 0xae, 0x55, 0xe9, 0xc7,
+	0xb5, 0x2a, 0x9d, 0xde, 0xa8, 0x85, 0x8b, 0x1b, 0xb5, 0xf0, 0xf5, 0x46, 0x2d, 0xbc, 0x6d, 0xf9,
+	0x21, 0x0f, 0xc6, 0x03, 0xcd, 0xa1, 0x91, 0x6e, 0x25, 0x63, 0xc6, 0x3d, 0xb7, 0x1f, 0x91, 0x84,
+	0xb7, 0x03, 0x12, 0xc6, 0x3a, 0x67, 0x8e, 0x7e, 0xdc, 0xd2, 0x4f, 0xf4, 0xfc, 0xa7, 0xe4, 0x93,
+	0x91, 0xc7, 0x06, 0x15, 0x31, 0x96, 0x97, 0xbf, 0x06, 0x00, 0x00, 0x95, 0x4c, 0xc1, 0xab, 0x03,
+	0x00, 0x00,
+}
+
+func (m *LockHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.BlockHeight != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.OldUnlockDate) > 0 {
+		i -= len(m.OldUnlockDate)
+		copy(dAtA[i:], m.OldUnlockDate)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.OldUnlockDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Change != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Change))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LockHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Change != 0 {
+		n += 1 + sovHistory(uint64(m.Change))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.OldUnlockDate)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovHistory(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovHistory(uint64(l))
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LockHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			m.Change = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Change |= LockHistoryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldUnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldUnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
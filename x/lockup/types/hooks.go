@@ -0,0 +1,54 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// combine multiple lockup hooks, all hook functions are run in array sequence
+var _ LockupHooks = &MultiLockupHooks{}
+
+type MultiLockupHooks []LockupHooks
+
+func NewMultiLockupHooks(hooks ...LockupHooks) MultiLockupHooks {
+	return hooks
+}
+
+func (h MultiLockupHooks) AfterLockCreated(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	for i := range h {
+		if err := h[i].AfterLockCreated(ctx, addr, unlockTime, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiLockupHooks) AfterLockExtended(ctx context.Context, addr sdk.AccAddress, fromTime, toTime time.Time, amount math.Int) error {
+	for i := range h {
+		if err := h[i].AfterLockExtended(ctx, addr, fromTime, toTime, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiLockupHooks) AfterLockExpired(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	for i := range h {
+		if err := h[i].AfterLockExpired(ctx, addr, unlockTime, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiLockupHooks) AfterLockRemoved(ctx context.Context, addr sdk.AccAddress, unlockTime time.Time, amount math.Int) error {
+	for i := range h {
+		if err := h[i].AfterLockRemoved(ctx, addr, unlockTime, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
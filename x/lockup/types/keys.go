@@ -1,5 +1,9 @@
 package types
 
+import (
+	"cosmossdk.io/collections"
+)
+
 const (
 	ModuleName = "lockup"
 
@@ -9,7 +13,52 @@ const (
 )
 
 var (
-	LocksByDateKey    = []byte("locks_by_date")
-	LocksByAddressKey = []byte("locks_by_address")
-	TotalLockedKey    = []byte("total_locked")
+	LocksByDateKey = []byte("locks_by_date")
+	TotalLockedKey = []byte("total_locked")
+
+	// ParamsKey saves the current module params.
+	ParamsKey = collections.NewPrefix(0)
+	// LocksByAddressKey saves the locks of each address, keyed by
+	// (address, unlock date).
+	LocksByAddressKey = collections.NewPrefix(1)
+	// SlashedLocksKey saves the lock amount removed from each address
+	// because of validator slashes.
+	SlashedLocksKey = collections.NewPrefix(2)
+	// LockSchedulesKey saves the locks released by schedule, keyed by
+	// (address, schedule id).
+	LockSchedulesKey = collections.NewPrefix(3)
+	// LockScheduleSequenceKey saves the id of the next lock schedule.
+	LockScheduleSequenceKey = collections.NewPrefix(4)
+	// LockSchedulesByReleaseKey indexes the lock schedules by the time their
+	// next step is released, keyed by (release time, address, schedule id).
+	LockSchedulesByReleaseKey = collections.NewPrefix(5)
+	// LockBindingsKey saves the part of each lock bound to a validator, keyed
+	// by (address, unlock date, validator).
+	LockBindingsKey = collections.NewPrefix(6)
+	// LockBindingsByValidatorKey indexes LockBindings by validator, keyed by
+	// (validator, address, unlock date).
+	LockBindingsByValidatorKey = collections.NewPrefix(7)
+	// LockHistoryKey saves the recorded lock changes of each address, keyed
+	// by (address, entry id).
+	LockHistoryKey = collections.NewPrefix(8)
+	// LockHistorySequenceKey saves the id of the next lock history entry.
+	LockHistorySequenceKey = collections.NewPrefix(9)
+	// LockHistoryByTimeKey indexes the lock history by block time for
+	// pruning, keyed by (block time, address, entry id).
+	LockHistoryByTimeKey = collections.NewPrefix(10)
+	// LockAllowancesKey saves the allowances to lock on behalf of another
+	// address, keyed by (owner, spender).
+	LockAllowancesKey = collections.NewPrefix(11)
+	// ScheduledLockedKey saves the amount of all lock schedules locked at
+	// the time saved under ScheduledLockedTimeKey.
+	ScheduledLockedKey = collections.NewPrefix(12)
+	// ScheduledLockedTimeKey saves the block time the lock schedule releases
+	// were last processed.
+	ScheduledLockedTimeKey = collections.NewPrefix(13)
+	// LockTransferAcceptancesKey saves the senders each address accepts lock
+	// transfers from, keyed by (address, sender).
+	LockTransferAcceptancesKey = collections.NewPrefix(14)
+	// ValidatorBoundTotalsKey saves the amount of all lock bindings to each
+	// validator.
+	ValidatorBoundTotalsKey = collections.NewPrefix(15)
 )
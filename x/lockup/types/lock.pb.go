@@ -28,6 +28,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Lock struct {
+	// unlock_date is the time the lock is released. It is a date (2006-01-02)
+	// for locks released at midnight UTC and an RFC3339 timestamp in UTC
+	// otherwise. Messages also accept Unix seconds.
 	UnlockDate string                `protobuf:"bytes,1,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
 	Amount     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
@@ -116,35 +119,105 @@ func (m *Locks) GetLocks() []*Lock {
 	return nil
 }
 
+// LockBinding is the part of the lock of address on unlock_date that is
+// backed by its delegation to validator_address.
+type LockBinding struct {
+	Address          string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnlockDate       string                `protobuf:"bytes,2,opt,name=unlock_date,json=unlockDate,proto3" json:"unlock_date,omitempty"`
+	ValidatorAddress string                `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *LockBinding) Reset()         { *m = LockBinding{} }
+func (m *LockBinding) String() string { return proto.CompactTextString(m) }
+func (*LockBinding) ProtoMessage()    {}
+func (*LockBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_961d2c254326faef, []int{2}
+}
+func (m *LockBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockBinding.Merge(m, src)
+}
+func (m *LockBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockBinding proto.InternalMessageInfo
+
+func (m *LockBinding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockBinding) GetUnlockDate() string {
+	if m != nil {
+		return m.UnlockDate
+	}
+	return ""
+}
+
+func (m *LockBinding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Lock)(nil), "lockup.v1.Lock")
 	proto.RegisterType((*Locks)(nil), "lockup.v1.Locks")
+	proto.RegisterType((*LockBinding)(nil), "lockup.v1.LockBinding")
 }
 
 func init() { proto.RegisterFile("lockup/v1/lock.proto", fileDescriptor_961d2c254326faef) }
 
 var fileDescriptor_961d2c254326faef = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xc1, 0x4e, 0x3a, 0x31,
-	0x10, 0xc6, 0xb7, 0xff, 0xbf, 0x90, 0x50, 0x0e, 0xc6, 0x0d, 0x26, 0xc8, 0xa1, 0x10, 0x12, 0x13,
-	0x62, 0x62, 0xc7, 0xd5, 0x37, 0x40, 0x0f, 0x92, 0x78, 0x42, 0x4f, 0x5e, 0x48, 0x77, 0xd9, 0xc0,
-	0x06, 0xdb, 0x41, 0x3a, 0x4b, 0xf4, 0x2d, 0x7c, 0x0c, 0x8f, 0x1e, 0x7c, 0x08, 0x8e, 0xc4, 0x93,
-	0xf1, 0x40, 0x0c, 0x1c, 0x7c, 0x0d, 0xd3, 0xed, 0xc6, 0x4b, 0x33, 0xf3, 0xfd, 0xa6, 0xfd, 0xfa,
-	0x0d, 0x6f, 0x3c, 0x60, 0x32, 0xcb, 0xe7, 0xb0, 0x8c, 0xc0, 0x55, 0x72, 0xbe, 0x40, 0xc2, 0xb0,
-	0xe6, 0x55, 0xb9, 0x8c, 0x5a, 0x8d, 0x09, 0x4e, 0xb0, 0x50, 0xc1, 0x55, 0x7e, 0xa0, 0x75, 0x94,
-	0xa0, 0xd5, 0x68, 0x47, 0x1e, 0xf8, 0xa6, 0x44, 0xc2, 0x77, 0x10, 0x2b, 0x9b, 0xc2, 0x32, 0x8a,
-	0x53, 0x52, 0x11, 0x24, 0x98, 0x99, 0x92, 0x1f, 0x28, 0x9d, 0x19, 0x84, 0xe2, 0xf4, 0x52, 0xf7,
-	0x91, 0xef, 0xdd, 0x60, 0x32, 0x0b, 0xdb, 0xbc, 0x9e, 0x1b, 0x67, 0x3d, 0x1a, 0x2b, 0x4a, 0x9b,
-	0xac, 0xc3, 0x7a, 0xb5, 0x21, 0xf7, 0xd2, 0x95, 0xa2, 0x34, 0xbc, 0xe6, 0x55, 0xa5, 0x31, 0x37,
-	0xd4, 0xfc, 0xe7, 0x58, 0xff, 0x6c, 0xb5, 0x69, 0x07, 0x5f, 0x9b, 0xf6, 0xa1, 0xf7, 0xb4, 0xe3,
-	0x99, 0xcc, 0x10, 0xb4, 0xa2, 0xa9, 0x1c, 0x18, 0xfa, 0x78, 0x3f, 0xe5, 0xe5, 0xd7, 0x06, 0x86,
-	0x5e, 0x7f, 0xde, 0x4e, 0xd8, 0xb0, 0xbc, 0xdf, 0x95, 0xbc, 0xe2, 0x2c, 0x6d, 0x78, 0xcc, 0x2b,
-	0xee, 0x79, 0xdb, 0x64, 0x9d, 0xff, 0xbd, 0xfa, 0xf9, 0xbe, 0xfc, 0x8b, 0x2e, 0xdd, 0xc0, 0xd0,
-	0xd3, 0xfe, 0x60, 0xb5, 0x15, 0x6c, 0xbd, 0x15, 0xec, 0x7b, 0x2b, 0xd8, 0xcb, 0x4e, 0x04, 0xeb,
-	0x9d, 0x08, 0x3e, 0x77, 0x22, 0xb8, 0x87, 0x49, 0x46, 0xd3, 0x3c, 0x96, 0x09, 0x6a, 0xb8, 0x5b,
-	0xe4, 0x96, 0xd2, 0xf1, 0xad, 0x56, 0x0b, 0xba, 0x9c, 0xaa, 0xcc, 0x00, 0xd9, 0x04, 0x9e, 0xa0,
-	0x5c, 0x32, 0x3d, 0xcf, 0x53, 0x1b, 0x57, 0x8b, 0xd0, 0x17, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0xb6, 0x08, 0xfd, 0x7b, 0x01, 0x00, 0x00,
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x52, 0xcd, 0xce, 0xd2, 0x40,
+	0x14, 0xed, 0xf0, 0x67, 0x18, 0x16, 0x4a, 0x83, 0x49, 0x25, 0xb1, 0x20, 0x89, 0x09, 0x31, 0x61,
+	0xc6, 0xd6, 0x27, 0xb0, 0xba, 0x90, 0x84, 0xb8, 0x28, 0xc6, 0x85, 0x1b, 0x32, 0xfd, 0x49, 0x99,
+	0x40, 0x67, 0xb0, 0x33, 0x6d, 0xf4, 0x2d, 0x7c, 0x0c, 0x97, 0x2e, 0x78, 0x08, 0x96, 0x84, 0x95,
+	0x71, 0x41, 0x0c, 0x2c, 0x7c, 0x09, 0x17, 0x66, 0x3a, 0xc5, 0x7c, 0x1f, 0xdf, 0xf2, 0xdb, 0x34,
+	0xf7, 0x9e, 0x73, 0x7a, 0xce, 0xbd, 0xb7, 0x85, 0xbd, 0x35, 0x0f, 0x57, 0xf9, 0x06, 0x17, 0x0e,
+	0x56, 0x15, 0xda, 0x64, 0x5c, 0x72, 0xb3, 0xad, 0x51, 0x54, 0x38, 0xfd, 0x5e, 0xc2, 0x13, 0x5e,
+	0xa2, 0x58, 0x55, 0x5a, 0xd0, 0x7f, 0x12, 0x72, 0x91, 0x72, 0xb1, 0xd0, 0x84, 0x6e, 0x2a, 0xca,
+	0xd6, 0x1d, 0x0e, 0x88, 0x88, 0x71, 0xe1, 0x04, 0xb1, 0x24, 0x0e, 0x0e, 0x39, 0x65, 0x15, 0xdf,
+	0x25, 0x29, 0x65, 0x1c, 0x97, 0x4f, 0x0d, 0x8d, 0x3e, 0xc3, 0xc6, 0x8c, 0x87, 0x2b, 0x73, 0x00,
+	0x3b, 0x39, 0x53, 0xd1, 0x8b, 0x88, 0xc8, 0xd8, 0x02, 0x43, 0x30, 0x6e, 0xfb, 0x50, 0x43, 0x6f,
+	0x89, 0x8c, 0xcd, 0x77, 0xb0, 0x45, 0x52, 0x9e, 0x33, 0x69, 0xd5, 0x14, 0xe7, 0xbd, 0xdc, 0x1d,
+	0x07, 0xc6, 0xaf, 0xe3, 0xe0, 0xb1, 0xce, 0x14, 0xd1, 0x0a, 0x51, 0x8e, 0x53, 0x22, 0x97, 0x68,
+	0xca, 0xe4, 0x61, 0x3b, 0x81, 0xd5, 0x68, 0x53, 0x26, 0xbf, 0xff, 0xf9, 0xf1, 0x02, 0xf8, 0xd5,
+	0xfb, 0x23, 0x04, 0x9b, 0x2a, 0x52, 0x98, 0xcf, 0x61, 0x53, 0xd9, 0x0b, 0x0b, 0x0c, 0xeb, 0xe3,
+	0x8e, 0xfb, 0x10, 0xfd, 0x5f, 0x1d, 0x29, 0x81, 0xaf, 0xd9, 0xd1, 0x5f, 0x00, 0x3b, 0xaa, 0xf7,
+	0x28, 0x8b, 0x28, 0x4b, 0x4c, 0x17, 0x3e, 0x20, 0x51, 0x94, 0xc5, 0x42, 0xe8, 0x31, 0x3d, 0xeb,
+	0xb0, 0x9d, 0xf4, 0xaa, 0xb4, 0xd7, 0x9a, 0x99, 0xcb, 0x8c, 0xb2, 0xc4, 0xbf, 0x08, 0xaf, 0xd7,
+	0xab, 0xdd, 0x59, 0xef, 0x3d, 0xec, 0x16, 0x64, 0x4d, 0x23, 0x22, 0x79, 0xb6, 0xb8, 0xd8, 0xd7,
+	0x4b, 0xfb, 0x67, 0x87, 0xed, 0xe4, 0x69, 0x65, 0xff, 0xf1, 0xa2, 0xb9, 0x9d, 0xf3, 0xa8, 0xb8,
+	0xc2, 0x6f, 0x9c, 0xab, 0x71, 0xbf, 0x73, 0x79, 0xb3, 0xdd, 0xc9, 0x06, 0xfb, 0x93, 0x0d, 0x7e,
+	0x9f, 0x6c, 0xf0, 0xed, 0x6c, 0x1b, 0xfb, 0xb3, 0x6d, 0xfc, 0x3c, 0xdb, 0xc6, 0x27, 0x37, 0xa1,
+	0x72, 0x99, 0x07, 0x28, 0xe4, 0x29, 0xfe, 0x90, 0xe5, 0x42, 0xc6, 0xd1, 0x3c, 0x25, 0x99, 0x7c,
+	0xb3, 0x24, 0x94, 0x61, 0x29, 0x42, 0x5c, 0xb8, 0xf8, 0x0b, 0xae, 0x7e, 0x33, 0xf9, 0x75, 0x13,
+	0x8b, 0xa0, 0x55, 0x7e, 0xf6, 0x57, 0xff, 0x06, 0x00, 0xd1, 0x44, 0xef, 0x22, 0x7d, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Lock) Marshal() (dAtA []byte, err error) {
@@ -224,6 +297,60 @@ func (m *Locks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LockBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.UnlockDate) > 0 {
+		i -= len(m.UnlockDate)
+		copy(dAtA[i:], m.UnlockDate)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.UnlockDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLock(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLock(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLock(v)
 	base := offset
@@ -265,6 +392,29 @@ func (m *Locks) Size() (n int) {
 	return n
 }
 
+func (m *LockBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = len(m.UnlockDate)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLock(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLock(uint64(l))
+	return n
+}
+
 func sovLock(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -471,6 +621,186 @@ func (m *Locks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnlockDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLock(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAcceptLockTransfers{}
+
+func NewMsgAcceptLockTransfers(address string, fromAddress string, accept bool) *MsgAcceptLockTransfers {
+	return &MsgAcceptLockTransfers{
+		Address:     address,
+		FromAddress: fromAddress,
+		Accept:      accept,
+	}
+}
+
+func (msg *MsgAcceptLockTransfers) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress address (%s)", err)
+	}
+
+	if msg.Address == msg.FromAddress {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "cannot accept lock transfers from the same address")
+	}
+
+	return nil
+}
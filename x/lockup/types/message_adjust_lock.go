@@ -0,0 +1,42 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAdjustLock{}
+
+func NewMsgAdjustLock(authority string, address string, unlockDate string, amount sdk.Coin, reason string) *MsgAdjustLock {
+	return &MsgAdjustLock{
+		Authority:  authority,
+		Address:    address,
+		UnlockDate: unlockDate,
+		Amount:     amount,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgAdjustLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lockup address (%s)", err)
+	}
+
+	if msg.UnlockDate == "" {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date")
+	}
+	if _, err := ParseUnlockTime(msg.UnlockDate); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+	}
+
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid lock amount: %s", msg.Amount.String())
+	}
+
+	return nil
+}
@@ -1,8 +1,6 @@
 package types
 
 import (
-	"time"
-
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,7 +34,7 @@ func (msg *MsgExtend) ValidateBasic() error {
 			return errorsmod.Wrapf(ErrInvalidDate, "extension from date cannot be empty")
 		}
 
-		fromTime, err := time.Parse(time.DateOnly, extension.FromDate)
+		fromTime, err := ParseUnlockTime(extension.FromDate)
 		if err != nil {
 			return errorsmod.Wrapf(ErrInvalidDate, "extension at index %d has invalid 'from' date format: %s", i, err)
 		}
@@ -49,7 +47,7 @@ func (msg *MsgExtend) ValidateBasic() error {
 			return errorsmod.Wrapf(ErrInvalidDate, "extension to date cannot be empty")
 		}
 
-		toTime, err := time.Parse(time.DateOnly, extension.ToDate)
+		toTime, err := ParseUnlockTime(extension.ToDate)
 		if err != nil {
 			return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", extension.ToDate)
 		}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgForceUnlock{}
+
+// NewMsgForceUnlock creates a new MsgForceUnlock. An empty unlockDate
+// removes all locks of the address.
+func NewMsgForceUnlock(authority string, address string, unlockDate string, reason string) *MsgForceUnlock {
+	return &MsgForceUnlock{
+		Authority:  authority,
+		Address:    address,
+		UnlockDate: unlockDate,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgForceUnlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid lockup address (%s)", err)
+	}
+
+	if msg.UnlockDate != "" {
+		if _, err := ParseUnlockTime(msg.UnlockDate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDate, "invalid unlock date format: %s", err)
+		}
+	}
+
+	return nil
+}
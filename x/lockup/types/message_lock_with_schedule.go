@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgLockWithSchedule{}
+
+func NewMsgLockWithSchedule(fromAddress string, toAddress string, validatorAddress string, amount sdk.Coin, schedule ReleaseSchedule) *MsgLockWithSchedule {
+	return &MsgLockWithSchedule{
+		FromAddress:      fromAddress,
+		ToAddress:        toAddress,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+		Schedule:         schedule,
+	}
+}
+
+func (msg *MsgLockWithSchedule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fromAddress address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid toAddress address (%s)", err)
+	}
+
+	_, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address (%s)", err)
+	}
+
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid lock amount: %s", msg.Amount.String())
+	}
+
+	if err := msg.Schedule.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidSchedule, err.Error())
+	}
+
+	return nil
+}
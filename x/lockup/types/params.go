@@ -0,0 +1,229 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const DefaultMaxLockMonths uint64 = 24
+const DefaultMaxUnlockDates uint64 = 0
+const DefaultSendDelegateAndLockEnabled bool = true
+const DefaultEarlyUnlockEnabled bool = false
+const DefaultBurnEarlyUnlockPenalty bool = false
+const DefaultLockTransferEnabled bool = true
+const DefaultLockHistoryEnabled bool = false
+const DefaultLockHistoryRetentionDays uint64 = 0
+const DefaultLockScheduleEnabled bool = true
+const DefaultMaxLockSchedules uint64 = 16
+
+// DefaultMinLockAmount is the default minimum amount of a single lock (no minimum).
+var DefaultMinLockAmount = math.ZeroInt()
+
+// DefaultEarlyUnlockPenaltyRate is the default early unlock penalty (10%).
+var DefaultEarlyUnlockPenaltyRate = math.LegacyNewDecWithPrec(1, 1)
+
+// DefaultLockTransferAllowlist is the default lock transfer allowlist (no restriction).
+var DefaultLockTransferAllowlist []string
+
+// DefaultPrecompileGas is the default gas schedule of the lockup precompile.
+// An extension or an output rewrites the locks of an address and two
+// expiration queue entries, so it costs about as much as a lock.
+var DefaultPrecompileGas = PrecompileGas{
+	LockGas:                     20_000,
+	ExtendGas:                   10_000,
+	PerExtensionGas:             20_000,
+	SendDelegateAndLockGas:      40_000,
+	MultiSendDelegateAndLockGas: 10_000,
+	PerOutputGas:                40_000,
+	UnlockEarlyGas:              30_000,
+	AllowanceGas:                5_000,
+	QueryGas:                    1_000,
+	PerResultGas:                1_000,
+}
+
+// MaxMaxLockMonths is the most months the max lock months param can be set
+// to (100 years).
+const MaxMaxLockMonths uint64 = 1200
+
+// MaxPrecompileGas is the most gas an amount of the precompile gas schedule
+// can be set to.
+const MaxPrecompileGas uint64 = 10_000_000
+
+// NewParams creates a new Params instance.
+func NewParams(
+	maxLockMonths uint64,
+	minLockAmount math.Int,
+	maxUnlockDates uint64,
+	sendDelegateAndLockEnabled bool,
+	earlyUnlockEnabled bool,
+	earlyUnlockPenaltyRate math.LegacyDec,
+	burnEarlyUnlockPenalty bool,
+	lockTransferEnabled bool,
+	lockTransferAllowlist []string,
+	lockHistoryEnabled bool,
+	lockHistoryRetentionDays uint64,
+	precompileGas PrecompileGas,
+	lockScheduleEnabled bool,
+	maxLockSchedules uint64,
+) Params {
+	return Params{
+		MaxLockMonths:              maxLockMonths,
+		MinLockAmount:              minLockAmount,
+		MaxUnlockDates:             maxUnlockDates,
+		SendDelegateAndLockEnabled: sendDelegateAndLockEnabled,
+		EarlyUnlockEnabled:         earlyUnlockEnabled,
+		EarlyUnlockPenaltyRate:     earlyUnlockPenaltyRate,
+		BurnEarlyUnlockPenalty:     burnEarlyUnlockPenalty,
+		LockTransferEnabled:        lockTransferEnabled,
+		LockTransferAllowlist:      lockTransferAllowlist,
+		LockHistoryEnabled:         lockHistoryEnabled,
+		LockHistoryRetentionDays:   lockHistoryRetentionDays,
+		PrecompileGas:              precompileGas,
+		LockScheduleEnabled:        lockScheduleEnabled,
+		MaxLockSchedules:           maxLockSchedules,
+	}
+}
+
+// DefaultParams returns the default lockup module params.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxLockMonths,
+		DefaultMinLockAmount,
+		DefaultMaxUnlockDates,
+		DefaultSendDelegateAndLockEnabled,
+		DefaultEarlyUnlockEnabled,
+		DefaultEarlyUnlockPenaltyRate,
+		DefaultBurnEarlyUnlockPenalty,
+		DefaultLockTransferEnabled,
+		DefaultLockTransferAllowlist,
+		DefaultLockHistoryEnabled,
+		DefaultLockHistoryRetentionDays,
+		DefaultPrecompileGas,
+		DefaultLockScheduleEnabled,
+		DefaultMaxLockSchedules,
+	)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateMaxLockMonths(p.MaxLockMonths); err != nil {
+		return err
+	}
+	if err := validateMinLockAmount(p.MinLockAmount); err != nil {
+		return err
+	}
+	if err := validateEarlyUnlockPenaltyRate(p.EarlyUnlockPenaltyRate); err != nil {
+		return err
+	}
+	if err := validateLockTransferAllowlist(p.LockTransferAllowlist); err != nil {
+		return err
+	}
+	if err := validatePrecompileGas(p.PrecompileGas); err != nil {
+		return err
+	}
+	if err := validateMaxLockSchedules(p.MaxLockSchedules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMaxLockMonths(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("max lock months must be greater than zero")
+	}
+	if v > MaxMaxLockMonths {
+		return fmt.Errorf("max lock months cannot be more than %d: %d", MaxMaxLockMonths, v)
+	}
+	return nil
+}
+
+func validateMinLockAmount(v math.Int) error {
+	if v.IsNil() {
+		return fmt.Errorf("min lock amount cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min lock amount cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateEarlyUnlockPenaltyRate(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("early unlock penalty rate cannot be nil")
+	}
+	if v.IsNegative() || v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("early unlock penalty rate must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateLockTransferAllowlist(v []string) error {
+	seen := make(map[string]struct{}, len(v))
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid lock transfer allowlist address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate lock transfer allowlist address: %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateMaxLockSchedules(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("max lock schedules must be greater than zero")
+	}
+	return nil
+}
+
+func validatePrecompileGas(v PrecompileGas) error {
+	for _, entry := range []struct {
+		name string
+		gas  uint64
+	}{
+		{"lock", v.LockGas},
+		{"extend", v.ExtendGas},
+		{"per extension", v.PerExtensionGas},
+		{"send delegate and lock", v.SendDelegateAndLockGas},
+		{"multi send delegate and lock", v.MultiSendDelegateAndLockGas},
+		{"per output", v.PerOutputGas},
+		{"unlock early", v.UnlockEarlyGas},
+		{"allowance", v.AllowanceGas},
+		{"query", v.QueryGas},
+		{"per result", v.PerResultGas},
+	} {
+		if entry.gas > MaxPrecompileGas {
+			return fmt.Errorf("precompile %s gas cannot be more than %d: %d", entry.name, MaxPrecompileGas, entry.gas)
+		}
+	}
+	return nil
+}
+
+// MaxUnlockTime returns the latest time a lock made at blockTime can unlock.
+func (p Params) MaxUnlockTime(blockTime time.Time) time.Time {
+	months := p.MaxLockMonths
+	if months > MaxMaxLockMonths {
+		months = MaxMaxLockMonths
+	}
+	return blockTime.AddDate(0, int(months), 0)
+}
+
+// IsLockTransferAllowed reports whether addr may send its locks with
+// MsgTransferLock.
+func (p Params) IsLockTransferAllowed(addr string) bool {
+	if len(p.LockTransferAllowlist) == 0 {
+		return true
+	}
+	for _, allowed := range p.LockTransferAllowlist {
+		if allowed == addr {
+			return true
+		}
+	}
+	return false
+}
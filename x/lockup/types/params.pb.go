@@ -0,0 +1,1525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lockup/v1/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of module parameters.
+type Params struct {
+	// max_lock_months is the furthest an unlock date may be set from the
+	// current block time, in calendar months.
+	MaxLockMonths uint64 `protobuf:"varint,1,opt,name=max_lock_months,json=maxLockMonths,proto3" json:"max_lock_months,omitempty"`
+	// min_lock_amount is the smallest amount accepted by a single lock.
+	MinLockAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_lock_amount,json=minLockAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_lock_amount"`
+	// max_unlock_dates is the maximum number of distinct unlock dates an address
+	// may hold at once. Zero means unlimited.
+	MaxUnlockDates uint64 `protobuf:"varint,3,opt,name=max_unlock_dates,json=maxUnlockDates,proto3" json:"max_unlock_dates,omitempty"`
+	// send_delegate_and_lock_enabled toggles MsgSendDelegateAndLock and
+	// MsgMultiSendDelegateAndLock.
+	SendDelegateAndLockEnabled bool `protobuf:"varint,4,opt,name=send_delegate_and_lock_enabled,json=sendDelegateAndLockEnabled,proto3" json:"send_delegate_and_lock_enabled,omitempty"`
+	// early_unlock_enabled toggles MsgUnlockEarly.
+	EarlyUnlockEnabled bool `protobuf:"varint,5,opt,name=early_unlock_enabled,json=earlyUnlockEnabled,proto3" json:"early_unlock_enabled,omitempty"`
+	// early_unlock_penalty_rate is the fraction of an early unlocked amount
+	// charged as a penalty, between 0 and 1.
+	EarlyUnlockPenaltyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=early_unlock_penalty_rate,json=earlyUnlockPenaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"early_unlock_penalty_rate"`
+	// burn_early_unlock_penalty burns the early unlock penalty instead of
+	// sending it to the community pool.
+	BurnEarlyUnlockPenalty bool `protobuf:"varint,7,opt,name=burn_early_unlock_penalty,json=burnEarlyUnlockPenalty,proto3" json:"burn_early_unlock_penalty,omitempty"`
+	// lock_transfer_enabled toggles MsgTransferLock.
+	LockTransferEnabled bool `protobuf:"varint,8,opt,name=lock_transfer_enabled,json=lockTransferEnabled,proto3" json:"lock_transfer_enabled,omitempty"`
+	// lock_transfer_allowlist restricts MsgTransferLock to the listed sender
+	// addresses. An empty list lets every address transfer its locks.
+	LockTransferAllowlist []string `protobuf:"bytes,9,rep,name=lock_transfer_allowlist,json=lockTransferAllowlist,proto3" json:"lock_transfer_allowlist,omitempty"`
+	// lock_history_enabled records the lock changes of every address in the
+	// lock history.
+	LockHistoryEnabled bool `protobuf:"varint,10,opt,name=lock_history_enabled,json=lockHistoryEnabled,proto3" json:"lock_history_enabled,omitempty"`
+	// lock_history_retention_days is how long lock history entries are kept.
+	// Zero keeps them forever.
+	LockHistoryRetentionDays uint64 `protobuf:"varint,11,opt,name=lock_history_retention_days,json=lockHistoryRetentionDays,proto3" json:"lock_history_retention_days,omitempty"`
+	// precompile_gas is the gas schedule of the lockup precompile.
+	PrecompileGas PrecompileGas `protobuf:"bytes,12,opt,name=precompile_gas,json=precompileGas,proto3" json:"precompile_gas"`
+	// lock_schedule_enabled toggles MsgLockWithSchedule.
+	LockScheduleEnabled bool `protobuf:"varint,13,opt,name=lock_schedule_enabled,json=lockScheduleEnabled,proto3" json:"lock_schedule_enabled,omitempty"`
+	// max_lock_schedules is the maximum number of lock schedules an address may
+	// hold at once. Every schedule of an address is read whenever its locks are
+	// checked, so it must be greater than zero.
+	MaxLockSchedules uint64 `protobuf:"varint,14,opt,name=max_lock_schedules,json=maxLockSchedules,proto3" json:"max_lock_schedules,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29fdcf1eb389cd9c, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxLockMonths() uint64 {
+	if m != nil {
+		return m.MaxLockMonths
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUnlockDates() uint64 {
+	if m != nil {
+		return m.MaxUnlockDates
+	}
+	return 0
+}
+
+func (m *Params) GetSendDelegateAndLockEnabled() bool {
+	if m != nil {
+		return m.SendDelegateAndLockEnabled
+	}
+	return false
+}
+
+func (m *Params) GetEarlyUnlockEnabled() bool {
+	if m != nil {
+		return m.EarlyUnlockEnabled
+	}
+	return false
+}
+
+func (m *Params) GetBurnEarlyUnlockPenalty() bool {
+	if m != nil {
+		return m.BurnEarlyUnlockPenalty
+	}
+	return false
+}
+
+func (m *Params) GetLockTransferEnabled() bool {
+	if m != nil {
+		return m.LockTransferEnabled
+	}
+	return false
+}
+
+func (m *Params) GetLockTransferAllowlist() []string {
+	if m != nil {
+		return m.LockTransferAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetLockHistoryEnabled() bool {
+	if m != nil {
+		return m.LockHistoryEnabled
+	}
+	return false
+}
+
+func (m *Params) GetLockHistoryRetentionDays() uint64 {
+	if m != nil {
+		return m.LockHistoryRetentionDays
+	}
+	return 0
+}
+
+func (m *Params) GetPrecompileGas() PrecompileGas {
+	if m != nil {
+		return m.PrecompileGas
+	}
+	return PrecompileGas{}
+}
+
+func (m *Params) GetLockScheduleEnabled() bool {
+	if m != nil {
+		return m.LockScheduleEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxLockSchedules() uint64 {
+	if m != nil {
+		return m.MaxLockSchedules
+	}
+	return 0
+}
+
+// PrecompileGas is the gas the lockup precompile charges on top of the flat
+// cost of a call, so that the cost of a call grows with the state it changes
+// or reads. A zero amount charges nothing extra.
+type PrecompileGas struct {
+	// lock_gas is charged by lock and lockUntil.
+	LockGas uint64 `protobuf:"varint,1,opt,name=lock_gas,json=lockGas,proto3" json:"lock_gas,omitempty"`
+	// extend_gas is charged by extend and extendTo.
+	ExtendGas uint64 `protobuf:"varint,2,opt,name=extend_gas,json=extendGas,proto3" json:"extend_gas,omitempty"`
+	// per_extension_gas is charged by extend and extendTo for each extension.
+	PerExtensionGas uint64 `protobuf:"varint,3,opt,name=per_extension_gas,json=perExtensionGas,proto3" json:"per_extension_gas,omitempty"`
+	// send_delegate_and_lock_gas is charged by sendDelegateAndLock and
+	// sendDelegateAndLockUntil.
+	SendDelegateAndLockGas uint64 `protobuf:"varint,4,opt,name=send_delegate_and_lock_gas,json=sendDelegateAndLockGas,proto3" json:"send_delegate_and_lock_gas,omitempty"`
+	// multi_send_delegate_and_lock_gas is charged by multiSendDelegateAndLock.
+	MultiSendDelegateAndLockGas uint64 `protobuf:"varint,5,opt,name=multi_send_delegate_and_lock_gas,json=multiSendDelegateAndLockGas,proto3" json:"multi_send_delegate_and_lock_gas,omitempty"`
+	// per_output_gas is charged by multiSendDelegateAndLock for each output.
+	PerOutputGas uint64 `protobuf:"varint,6,opt,name=per_output_gas,json=perOutputGas,proto3" json:"per_output_gas,omitempty"`
+	// unlock_early_gas is charged by unlockEarly.
+	UnlockEarlyGas uint64 `protobuf:"varint,7,opt,name=unlock_early_gas,json=unlockEarlyGas,proto3" json:"unlock_early_gas,omitempty"`
+	// allowance_gas is charged by approve and revoke.
+	AllowanceGas uint64 `protobuf:"varint,9,opt,name=allowance_gas,json=allowanceGas,proto3" json:"allowance_gas,omitempty"`
+	// query_gas is charged by every query.
+	QueryGas uint64 `protobuf:"varint,10,opt,name=query_gas,json=queryGas,proto3" json:"query_gas,omitempty"`
+	// per_result_gas is charged by queries for each lock they return.
+	PerResultGas uint64 `protobuf:"varint,11,opt,name=per_result_gas,json=perResultGas,proto3" json:"per_result_gas,omitempty"`
+}
+
+func (m *PrecompileGas) Reset()         { *m = PrecompileGas{} }
+func (m *PrecompileGas) String() string { return proto.CompactTextString(m) }
+func (*PrecompileGas) ProtoMessage()    {}
+func (*PrecompileGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29fdcf1eb389cd9c, []int{1}
+}
+func (m *PrecompileGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileGas.Merge(m, src)
+}
+func (m *PrecompileGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileGas proto.InternalMessageInfo
+
+func (m *PrecompileGas) GetLockGas() uint64 {
+	if m != nil {
+		return m.LockGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetExtendGas() uint64 {
+	if m != nil {
+		return m.ExtendGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetPerExtensionGas() uint64 {
+	if m != nil {
+		return m.PerExtensionGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetSendDelegateAndLockGas() uint64 {
+	if m != nil {
+		return m.SendDelegateAndLockGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetMultiSendDelegateAndLockGas() uint64 {
+	if m != nil {
+		return m.MultiSendDelegateAndLockGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetPerOutputGas() uint64 {
+	if m != nil {
+		return m.PerOutputGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetUnlockEarlyGas() uint64 {
+	if m != nil {
+		return m.UnlockEarlyGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetAllowanceGas() uint64 {
+	if m != nil {
+		return m.AllowanceGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetQueryGas() uint64 {
+	if m != nil {
+		return m.QueryGas
+	}
+	return 0
+}
+
+func (m *PrecompileGas) GetPerResultGas() uint64 {
+	if m != nil {
+		return m.PerResultGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "lockup.v1.Params")
+	proto.RegisterType((*PrecompileGas)(nil), "lockup.v1.PrecompileGas")
+}
+
+func init() { proto.RegisterFile("lockup/v1/params.proto", fileDescriptor_29fdcf1eb389cd9c) }
+
+var fileDescriptor_29fdcf1eb389cd9c = []byte{
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x3f, 0x6f, 0xdb, 0x46,
+	0x1c, 0x35, 0x63, 0xc5, 0x16, 0xcf, 0x91, 0xff, 0x30, 0xb6, 0x4a, 0x49, 0xad, 0x2c, 0xa4, 0x45,
+	0x21, 0x18, 0x8d, 0x98, 0xa8, 0x40, 0x81, 0x1a, 0xe8, 0x20, 0x55, 0x42, 0x9a, 0xc0, 0x45, 0x05,
+	0x2a, 0x05, 0x8a, 0x2e, 0xc4, 0x89, 0xbc, 0x52, 0x84, 0xc9, 0x3b, 0xe6, 0xee, 0xe8, 0x4a, 0x5f,
+	0xa1, 0x53, 0xf7, 0x2e, 0x1d, 0x3b, 0x66, 0xc8, 0x87, 0xc8, 0xd6, 0x20, 0x53, 0xd1, 0x21, 0x28,
+	0xec, 0x21, 0xfd, 0x18, 0xc5, 0xfd, 0x8e, 0x64, 0x24, 0xd8, 0xcd, 0x62, 0x58, 0xef, 0xf7, 0xde,
+	0xbb, 0xc7, 0x1f, 0xef, 0x11, 0xd5, 0x63, 0xe6, 0x9f, 0x67, 0xa9, 0x73, 0xf1, 0xd0, 0x49, 0x31,
+	0xc7, 0x89, 0xe8, 0xa5, 0x9c, 0x49, 0x66, 0x99, 0x1a, 0xef, 0x5d, 0x3c, 0x6c, 0x1e, 0x86, 0x2c,
+	0x64, 0x80, 0x3a, 0xea, 0x3f, 0x4d, 0x68, 0x36, 0x7c, 0x26, 0x12, 0x26, 0x3c, 0x3d, 0xd0, 0x3f,
+	0xf2, 0xd1, 0x01, 0x4e, 0x22, 0xca, 0x1c, 0xf8, 0xab, 0xa1, 0x7b, 0xbf, 0x6d, 0xa3, 0xad, 0x09,
+	0xf8, 0x5b, 0x9f, 0xa2, 0xbd, 0x04, 0x2f, 0x3c, 0xe5, 0xef, 0x25, 0x8c, 0xca, 0xb9, 0xb0, 0x8d,
+	0x8e, 0xd1, 0xad, 0xb8, 0xb5, 0x04, 0x2f, 0xce, 0x98, 0x7f, 0xfe, 0x2d, 0x80, 0xd6, 0x0f, 0x68,
+	0x2f, 0x89, 0xa8, 0xe6, 0xe1, 0x84, 0x65, 0x54, 0xda, 0xb7, 0x3a, 0x46, 0xd7, 0x1c, 0x3e, 0x78,
+	0xf9, 0xe6, 0x78, 0xe3, 0xef, 0x37, 0xc7, 0x47, 0xfa, 0x50, 0x11, 0x9c, 0xf7, 0x22, 0xe6, 0x24,
+	0x58, 0xce, 0x7b, 0x8f, 0xa9, 0x7c, 0xfd, 0xe2, 0x3e, 0xca, 0xd3, 0x3c, 0xa6, 0xf2, 0x8f, 0xb7,
+	0xcf, 0x4f, 0x0c, 0xb7, 0x96, 0x44, 0x54, 0x39, 0x0f, 0xc0, 0xc6, 0xea, 0xa2, 0x7d, 0x95, 0x20,
+	0xa3, 0xe0, 0x1d, 0x60, 0x49, 0x84, 0xbd, 0x09, 0x11, 0x76, 0x13, 0xbc, 0xf8, 0x1e, 0xe0, 0x91,
+	0x42, 0xad, 0x21, 0x6a, 0x0b, 0x42, 0x03, 0x2f, 0x20, 0x31, 0x09, 0xb1, 0x24, 0x1e, 0xa6, 0x81,
+	0x4e, 0x44, 0x28, 0x9e, 0xc5, 0x24, 0xb0, 0x2b, 0x1d, 0xa3, 0x5b, 0x75, 0x9b, 0x8a, 0x35, 0xca,
+	0x49, 0x03, 0x1a, 0xa8, 0xc3, 0xc6, 0x9a, 0x61, 0x3d, 0x40, 0x87, 0x04, 0xf3, 0x78, 0x59, 0x9c,
+	0x57, 0x28, 0x6f, 0x83, 0xd2, 0x82, 0x99, 0x3e, 0xb3, 0x50, 0x3c, 0x43, 0x8d, 0x35, 0x45, 0x4a,
+	0x28, 0x8e, 0xe5, 0xd2, 0xe3, 0x58, 0x12, 0x7b, 0x0b, 0x76, 0xf0, 0x45, 0xbe, 0x83, 0xd6, 0xf5,
+	0x1d, 0x9c, 0x91, 0x10, 0xfb, 0xcb, 0x11, 0xf1, 0x57, 0x36, 0x31, 0x22, 0xbe, 0xde, 0x44, 0x7d,
+	0xe5, 0xb8, 0x89, 0xb6, 0x75, 0xb1, 0x24, 0xd6, 0x97, 0xa8, 0x31, 0xcb, 0x38, 0xf5, 0x6e, 0x3a,
+	0xd7, 0xde, 0x86, 0xa4, 0x75, 0x45, 0x18, 0x5f, 0x93, 0x5b, 0x7d, 0x74, 0x04, 0x6c, 0xc9, 0x31,
+	0x15, 0x3f, 0x11, 0x5e, 0x3e, 0x60, 0x15, 0x64, 0x77, 0xd5, 0xf0, 0x69, 0x3e, 0x2b, 0x9e, 0x70,
+	0x82, 0x3e, 0x58, 0xd7, 0xe0, 0x38, 0x66, 0x3f, 0xc7, 0x91, 0x90, 0xb6, 0xd9, 0xd9, 0xec, 0x9a,
+	0x43, 0xfb, 0xf5, 0x8b, 0xfb, 0x87, 0x79, 0xf8, 0x41, 0x10, 0x70, 0x22, 0xc4, 0x54, 0xf2, 0x88,
+	0x86, 0xee, 0xd1, 0xaa, 0xdf, 0xa0, 0x90, 0xa9, 0x2d, 0x83, 0xe3, 0x3c, 0x12, 0x92, 0xf1, 0x65,
+	0x19, 0x02, 0xe9, 0x2d, 0xab, 0xd9, 0x37, 0x7a, 0x54, 0x64, 0xf8, 0x0a, 0xb5, 0xd6, 0x14, 0x9c,
+	0x48, 0x42, 0x65, 0xc4, 0xa8, 0x17, 0xe0, 0xa5, 0xb0, 0x77, 0xe0, 0x42, 0xd8, 0x2b, 0x42, 0xb7,
+	0x20, 0x8c, 0xf0, 0x52, 0x58, 0x4f, 0xd0, 0x6e, 0xca, 0x89, 0xcf, 0x92, 0x34, 0x8a, 0x89, 0x17,
+	0x62, 0x61, 0xdf, 0xe9, 0x18, 0xdd, 0x9d, 0xbe, 0xdd, 0x2b, 0x9b, 0xd3, 0x9b, 0x94, 0x84, 0x47,
+	0x58, 0x0c, 0x4d, 0xf5, 0xce, 0xf2, 0x0b, 0x99, 0xae, 0x4e, 0xca, 0x15, 0x0a, 0x7f, 0x4e, 0x82,
+	0x2c, 0x26, 0x65, 0xfa, 0xda, 0xbb, 0x15, 0x4e, 0xf3, 0x59, 0x11, 0xff, 0x33, 0x64, 0x95, 0x35,
+	0x2a, 0x74, 0xc2, 0xde, 0x85, 0xd4, 0xfb, 0x79, 0x93, 0x0a, 0x8d, 0x38, 0xfd, 0xf0, 0xdf, 0xdf,
+	0x8f, 0x8d, 0x5f, 0xde, 0x3e, 0x3f, 0xb9, 0x2b, 0x85, 0xef, 0x2c, 0x9c, 0xbc, 0xf5, 0xba, 0xf2,
+	0xf7, 0xfe, 0xdc, 0x44, 0xb5, 0xb5, 0xac, 0x56, 0x03, 0x55, 0xc1, 0x39, 0xc4, 0x45, 0x3b, 0xb7,
+	0xd5, 0x6f, 0x35, 0xfa, 0x08, 0x21, 0xb2, 0x90, 0xaa, 0x15, 0x6a, 0x78, 0x0b, 0x86, 0xa6, 0x46,
+	0xd4, 0xf8, 0x04, 0x1d, 0xa4, 0xea, 0x12, 0x28, 0x40, 0xa8, 0x6d, 0x86, 0xb8, 0x68, 0xd7, 0x5e,
+	0x4a, 0xf8, 0xb8, 0xc0, 0x15, 0xf7, 0x14, 0x35, 0xff, 0xa7, 0x5e, 0x4a, 0x54, 0x01, 0x51, 0xfd,
+	0x86, 0x6a, 0x29, 0xed, 0x18, 0x75, 0x92, 0x2c, 0x96, 0x91, 0xf7, 0x1e, 0x87, 0xdb, 0xe0, 0xd0,
+	0x02, 0xde, 0xf4, 0x66, 0x9b, 0x4f, 0xd0, 0xae, 0x8a, 0xcb, 0x32, 0x99, 0x66, 0x12, 0x44, 0x5b,
+	0x20, 0xba, 0x93, 0x12, 0xfe, 0x1d, 0x80, 0x8a, 0xd5, 0x45, 0xfb, 0x45, 0x7b, 0xa1, 0x20, 0x8a,
+	0xb7, 0xad, 0xbf, 0x18, 0x1a, 0x87, 0x5e, 0x28, 0xe6, 0xc7, 0xa8, 0x06, 0x77, 0x19, 0x53, 0x5f,
+	0xdf, 0x0a, 0x53, 0xdb, 0x95, 0xa0, 0x22, 0xb5, 0x90, 0xf9, 0x2c, 0x23, 0x5c, 0xfb, 0x20, 0x20,
+	0x54, 0x01, 0x58, 0x49, 0xc4, 0x89, 0xc8, 0x62, 0x9d, 0x68, 0xa7, 0x4c, 0xe4, 0x02, 0xf8, 0x08,
+	0x8b, 0xd3, 0x8a, 0x7a, 0xa1, 0x4f, 0x2a, 0xd5, 0xea, 0xbe, 0xe9, 0x1e, 0x94, 0x35, 0x2a, 0x9e,
+	0x7c, 0x78, 0xf6, 0xf2, 0xb2, 0x6d, 0xbc, 0xba, 0x6c, 0x1b, 0xff, 0x5c, 0xb6, 0x8d, 0x5f, 0xaf,
+	0xda, 0x1b, 0xaf, 0xae, 0xda, 0x1b, 0x7f, 0x5d, 0xb5, 0x37, 0x7e, 0xec, 0x87, 0x91, 0x9c, 0x67,
+	0xb3, 0x9e, 0xcf, 0x12, 0xe7, 0x29, 0xcf, 0x84, 0x24, 0xc1, 0x34, 0xc1, 0x5c, 0x7e, 0x3d, 0xc7,
+	0x11, 0x75, 0xd4, 0xed, 0xb8, 0xe8, 0xbf, 0xbb, 0x20, 0x72, 0x99, 0x12, 0x31, 0xdb, 0x82, 0x8f,
+	0xf8, 0xe7, 0xff, 0x0d, 0x00, 0xba, 0x14, 0xd8, 0x8a, 0x2d, 0x06, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxLockMonths != that1.MaxLockMonths {
+		return false
+	}
+	if !this.MinLockAmount.Equal(that1.MinLockAmount) {
+		return false
+	}
+	if this.MaxUnlockDates != that1.MaxUnlockDates {
+		return false
+	}
+	if this.SendDelegateAndLockEnabled != that1.SendDelegateAndLockEnabled {
+		return false
+	}
+	if this.EarlyUnlockEnabled != that1.EarlyUnlockEnabled {
+		return false
+	}
+	if !this.EarlyUnlockPenaltyRate.Equal(that1.EarlyUnlockPenaltyRate) {
+		return false
+	}
+	if this.BurnEarlyUnlockPenalty != that1.BurnEarlyUnlockPenalty {
+		return false
+	}
+	if this.LockTransferEnabled != that1.LockTransferEnabled {
+		return false
+	}
+	if len(this.LockTransferAllowlist) != len(that1.LockTransferAllowlist) {
+		return false
+	}
+	for i := range this.LockTransferAllowlist {
+		if this.LockTransferAllowlist[i] != that1.LockTransferAllowlist[i] {
+			return false
+		}
+	}
+	if this.LockHistoryEnabled != that1.LockHistoryEnabled {
+		return false
+	}
+	if this.LockHistoryRetentionDays != that1.LockHistoryRetentionDays {
+		return false
+	}
+	if !this.PrecompileGas.Equal(&that1.PrecompileGas) {
+		return false
+	}
+	if this.LockScheduleEnabled != that1.LockScheduleEnabled {
+		return false
+	}
+	if this.MaxLockSchedules != that1.MaxLockSchedules {
+		return false
+	}
+	return true
+}
+func (this *PrecompileGas) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrecompileGas)
+	if !ok {
+		that2, ok := that.(PrecompileGas)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LockGas != that1.LockGas {
+		return false
+	}
+	if this.ExtendGas != that1.ExtendGas {
+		return false
+	}
+	if this.PerExtensionGas != that1.PerExtensionGas {
+		return false
+	}
+	if this.SendDelegateAndLockGas != that1.SendDelegateAndLockGas {
+		return false
+	}
+	if this.MultiSendDelegateAndLockGas != that1.MultiSendDelegateAndLockGas {
+		return false
+	}
+	if this.PerOutputGas != that1.PerOutputGas {
+		return false
+	}
+	if this.UnlockEarlyGas != that1.UnlockEarlyGas {
+		return false
+	}
+	if this.AllowanceGas != that1.AllowanceGas {
+		return false
+	}
+	if this.QueryGas != that1.QueryGas {
+		return false
+	}
+	if this.PerResultGas != that1.PerResultGas {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLockSchedules != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLockSchedules))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LockScheduleEnabled {
+		i--
+		if m.LockScheduleEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.PrecompileGas.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.LockHistoryRetentionDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockHistoryRetentionDays))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.LockHistoryEnabled {
+		i--
+		if m.LockHistoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LockTransferAllowlist) > 0 {
+		for iNdEx := len(m.LockTransferAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockTransferAllowlist[iNdEx])
+			copy(dAtA[i:], m.LockTransferAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.LockTransferAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.LockTransferEnabled {
+		i--
+		if m.LockTransferEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BurnEarlyUnlockPenalty {
+		i--
+		if m.BurnEarlyUnlockPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.EarlyUnlockPenaltyRate.Size()
+		i -= size
+		if _, err := m.EarlyUnlockPenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EarlyUnlockEnabled {
+		i--
+		if m.EarlyUnlockEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SendDelegateAndLockEnabled {
+		i--
+		if m.SendDelegateAndLockEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUnlockDates != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUnlockDates))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinLockAmount.Size()
+		i -= size
+		if _, err := m.MinLockAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxLockMonths != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLockMonths))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerResultGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PerResultGas))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.QueryGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueryGas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AllowanceGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AllowanceGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UnlockEarlyGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnlockEarlyGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PerOutputGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PerOutputGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MultiSendDelegateAndLockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiSendDelegateAndLockGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SendDelegateAndLockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SendDelegateAndLockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PerExtensionGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PerExtensionGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExtendGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExtendGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLockMonths != 0 {
+		n += 1 + sovParams(uint64(m.MaxLockMonths))
+	}
+	l = m.MinLockAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxUnlockDates != 0 {
+		n += 1 + sovParams(uint64(m.MaxUnlockDates))
+	}
+	if m.SendDelegateAndLockEnabled {
+		n += 2
+	}
+	if m.EarlyUnlockEnabled {
+		n += 2
+	}
+	l = m.EarlyUnlockPenaltyRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BurnEarlyUnlockPenalty {
+		n += 2
+	}
+	if m.LockTransferEnabled {
+		n += 2
+	}
+	if len(m.LockTransferAllowlist) > 0 {
+		for _, s := range m.LockTransferAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.LockHistoryEnabled {
+		n += 2
+	}
+	if m.LockHistoryRetentionDays != 0 {
+		n += 1 + sovParams(uint64(m.LockHistoryRetentionDays))
+	}
+	l = m.PrecompileGas.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.LockScheduleEnabled {
+		n += 2
+	}
+	if m.MaxLockSchedules != 0 {
+		n += 1 + sovParams(uint64(m.MaxLockSchedules))
+	}
+	return n
+}
+
+func (m *PrecompileGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockGas != 0 {
+		n += 1 + sovParams(uint64(m.LockGas))
+	}
+	if m.ExtendGas != 0 {
+		n += 1 + sovParams(uint64(m.ExtendGas))
+	}
+	if m.PerExtensionGas != 0 {
+		n += 1 + sovParams(uint64(m.PerExtensionGas))
+	}
+	if m.SendDelegateAndLockGas != 0 {
+		n += 1 + sovParams(uint64(m.SendDelegateAndLockGas))
+	}
+	if m.MultiSendDelegateAndLockGas != 0 {
+		n += 1 + sovParams(uint64(m.MultiSendDelegateAndLockGas))
+	}
+	if m.PerOutputGas != 0 {
+		n += 1 + sovParams(uint64(m.PerOutputGas))
+	}
+	if m.UnlockEarlyGas != 0 {
+		n += 1 + sovParams(uint64(m.UnlockEarlyGas))
+	}
+	if m.AllowanceGas != 0 {
+		n += 1 + sovParams(uint64(m.AllowanceGas))
+	}
+	if m.QueryGas != 0 {
+		n += 1 + sovParams(uint64(m.QueryGas))
+	}
+	if m.PerResultGas != 0 {
+		n += 1 + sovParams(uint64(m.PerResultGas))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockMonths", wireType)
+			}
+			m.MaxLockMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLockMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLockAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockDates", wireType)
+			}
+			m.MaxUnlockDates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnlockDates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendDelegateAndLockEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendDelegateAndLockEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EarlyUnlockEnabled = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyUnlockPenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyUnlockPenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEarlyUnlockPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnEarlyUnlockPenalty = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTransferEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockTransferEnabled = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTransferAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTransferAllowlist = append(m.LockTransferAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHistoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockHistoryEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHistoryRetentionDays", wireType)
+			}
+			m.LockHistoryRetentionDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockHistoryRetentionDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrecompileGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockScheduleEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LockScheduleEnabled = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockSchedules", wireType)
+			}
+			m.MaxLockSchedules = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLockSchedules |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockGas", wireType)
+			}
+			m.LockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendGas", wireType)
+			}
+			m.ExtendGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExtendGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerExtensionGas", wireType)
+			}
+			m.PerExtensionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerExtensionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendDelegateAndLockGas", wireType)
+			}
+			m.SendDelegateAndLockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendDelegateAndLockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSendDelegateAndLockGas", wireType)
+			}
+			m.MultiSendDelegateAndLockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiSendDelegateAndLockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerOutputGas", wireType)
+			}
+			m.PerOutputGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerOutputGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockEarlyGas", wireType)
+			}
+			m.UnlockEarlyGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockEarlyGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowanceGas", wireType)
+			}
+			m.AllowanceGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AllowanceGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryGas", wireType)
+			}
+			m.QueryGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerResultGas", wireType)
+			}
+			m.PerResultGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerResultGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// MaxReleasePeriods bounds the number of steps of a release schedule. The
+// max_lock_months param is usually the tighter limit.
+const MaxReleasePeriods = 3660
+
+// Validate checks that the schedule can be evaluated.
+func (s ReleaseSchedule) Validate() error {
+	if _, err := ParseUnlockTime(s.CliffDate); err != nil {
+		return fmt.Errorf("invalid cliff date: %w", err)
+	}
+
+	if s.ReleaseType != RELEASE_TYPE_LINEAR && s.ReleaseType != RELEASE_TYPE_MONTHLY {
+		return fmt.Errorf("invalid release type: %s", s.ReleaseType)
+	}
+
+	if s.Periods == 0 || s.Periods > MaxReleasePeriods {
+		return fmt.Errorf("periods must be between 1 and %d, got %d", MaxReleasePeriods, s.Periods)
+	}
+
+	return nil
+}
+
+// ReleaseTime returns the time step i (zero based) is released. The schedule
+// must be valid.
+func (s ReleaseSchedule) ReleaseTime(i uint32) time.Time {
+	cliff, _ := ParseUnlockTime(s.CliffDate)
+	if s.ReleaseType == RELEASE_TYPE_MONTHLY {
+		return cliff.AddDate(0, int(i), 0)
+	}
+
+	return cliff.Add(time.Duration(i) * 24 * time.Hour)
+}
+
+// EndTime returns the time the last step is released.
+func (s ReleaseSchedule) EndTime() time.Time {
+	return s.ReleaseTime(s.Periods - 1)
+}
+
+// NextReleaseTime returns the time the first step after currentTime is
+// released. found is false once every step is released.
+func (s ReleaseSchedule) NextReleaseTime(currentTime time.Time) (releaseTime time.Time, found bool) {
+	steps := s.releasedSteps(currentTime)
+	if steps >= s.Periods {
+		return time.Time{}, false
+	}
+
+	return s.ReleaseTime(steps), true
+}
+
+// releasedSteps returns the number of steps released at currentTime.
+func (s ReleaseSchedule) releasedSteps(currentTime time.Time) uint32 {
+	cliff, _ := ParseUnlockTime(s.CliffDate)
+	if currentTime.Before(cliff) {
+		return 0
+	}
+
+	if s.ReleaseType == RELEASE_TYPE_LINEAR {
+		steps := uint64(currentTime.Sub(cliff)/(24*time.Hour)) + 1
+		if steps > uint64(s.Periods) {
+			return s.Periods
+		}
+		return uint32(steps)
+	}
+
+	var steps uint32
+	for steps < s.Periods && !currentTime.Before(s.ReleaseTime(steps)) {
+		steps++
+	}
+
+	return steps
+}
+
+// LockedAt returns the amount of the schedule still locked at currentTime.
+// Slashed tokens come off the steps released last.
+func (l LockSchedule) LockedAt(currentTime time.Time) math.Int {
+	steps := l.Schedule.releasedSteps(currentTime)
+
+	released := l.Amount
+	if steps < l.Schedule.Periods {
+		released = l.Amount.QuoRaw(int64(l.Schedule.Periods)).MulRaw(int64(steps))
+	}
+
+	locked := l.Amount.Sub(released).Sub(l.Slashed)
+	if locked.IsNegative() {
+		return math.ZeroInt()
+	}
+
+	return locked
+}
+
+// NextRelease returns the next time part of the schedule is released after
+// currentTime and the amount released then. found is false once nothing is
+// locked anymore.
+func (l LockSchedule) NextRelease(currentTime time.Time) (releaseTime time.Time, amount math.Int, found bool) {
+	locked := l.LockedAt(currentTime)
+	if !locked.IsPositive() {
+		return time.Time{}, math.ZeroInt(), false
+	}
+
+	releaseTime = l.Schedule.ReleaseTime(l.Schedule.releasedSteps(currentTime))
+
+	return releaseTime, locked.Sub(l.LockedAt(releaseTime)), true
+}
@@ -0,0 +1,803 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lockup/v1/schedule.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReleaseType is the spacing between the release steps of a schedule.
+type ReleaseType int32
+
+const (
+	RELEASE_TYPE_UNSPECIFIED ReleaseType = 0
+	// RELEASE_TYPE_LINEAR releases one step per day.
+	RELEASE_TYPE_LINEAR ReleaseType = 1
+	// RELEASE_TYPE_MONTHLY releases one step per calendar month.
+	RELEASE_TYPE_MONTHLY ReleaseType = 2
+)
+
+var ReleaseType_name = map[int32]string{
+	0: "RELEASE_TYPE_UNSPECIFIED",
+	1: "RELEASE_TYPE_LINEAR",
+	2: "RELEASE_TYPE_MONTHLY",
+}
+
+var ReleaseType_value = map[string]int32{
+	"RELEASE_TYPE_UNSPECIFIED": 0,
+	"RELEASE_TYPE_LINEAR":      1,
+	"RELEASE_TYPE_MONTHLY":     2,
+}
+
+func (x ReleaseType) String() string {
+	return proto.EnumName(ReleaseType_name, int32(x))
+}
+
+func (ReleaseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_83e63512717ce243, []int{0}
+}
+
+// ReleaseSchedule releases a lock in equal steps. The first step is released
+// at cliff_date and the remaining ones one interval apart; the last step also
+// releases the truncation leftover.
+type ReleaseSchedule struct {
+	// cliff_date accepts the same formats as Lock.unlock_date.
+	CliffDate   string      `protobuf:"bytes,1,opt,name=cliff_date,json=cliffDate,proto3" json:"cliff_date,omitempty"`
+	ReleaseType ReleaseType `protobuf:"varint,2,opt,name=release_type,json=releaseType,proto3,enum=lockup.v1.ReleaseType" json:"release_type,omitempty"`
+	// periods is the number of release steps.
+	Periods uint32 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
+func (m *ReleaseSchedule) String() string { return proto.CompactTextString(m) }
+func (*ReleaseSchedule) ProtoMessage()    {}
+func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83e63512717ce243, []int{0}
+}
+func (m *ReleaseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSchedule.Merge(m, src)
+}
+func (m *ReleaseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSchedule proto.InternalMessageInfo
+
+func (m *ReleaseSchedule) GetCliffDate() string {
+	if m != nil {
+		return m.CliffDate
+	}
+	return ""
+}
+
+func (m *ReleaseSchedule) GetReleaseType() ReleaseType {
+	if m != nil {
+		return m.ReleaseType
+	}
+	return RELEASE_TYPE_UNSPECIFIED
+}
+
+func (m *ReleaseSchedule) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// LockSchedule is a lock whose locked amount decreases over time following
+// its release schedule.
+type LockSchedule struct {
+	Id       uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address  string          `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Schedule ReleaseSchedule `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule"`
+	// amount is the amount originally locked.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// slashed is the part of amount removed because of validator slashes. It
+	// is taken from the steps released last.
+	Slashed cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=slashed,proto3,customtype=cosmossdk.io/math.Int" json:"slashed"`
+}
+
+func (m *LockSchedule) Reset()         { *m = LockSchedule{} }
+func (m *LockSchedule) String() string { return proto.CompactTextString(m) }
+func (*LockSchedule) ProtoMessage()    {}
+func (*LockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83e63512717ce243, []int{1}
+}
+func (m *LockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSchedule.Merge(m, src)
+}
+func (m *LockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSchedule proto.InternalMessageInfo
+
+func (m *LockSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LockSchedule) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LockSchedule) GetSchedule() ReleaseSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ReleaseSchedule{}
+}
+
+func init() {
+	proto.RegisterEnum("lockup.v1.ReleaseType", ReleaseType_name, ReleaseType_value)
+	proto.RegisterType((*ReleaseSchedule)(nil), "lockup.v1.ReleaseSchedule")
+	proto.RegisterType((*LockSchedule)(nil), "lockup.v1.LockSchedule")
+}
+
+func init() { proto.RegisterFile("lockup/v1/schedule.proto", fileDescriptor_83e63512717ce243) }
+
+var fileDescriptor_83e63512717ce243 = []byte{
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0x21, 0x34, 0x64, 0x53, 0x4a, 0x58, 0x02, 0x2c, 0x11, 0xb8, 0x51, 0x4f, 0x51,
+	0xa5, 0x7a, 0x69, 0x38, 0x21, 0x71, 0x49, 0x5a, 0xa3, 0x06, 0x85, 0x50, 0x6d, 0xc2, 0xa1, 0x5c,
+	0xa2, 0xad, 0x77, 0x9b, 0x58, 0xb1, 0xbd, 0x96, 0x77, 0x1d, 0xd1, 0x07, 0x40, 0xe2, 0xc8, 0x3b,
+	0x20, 0x21, 0x8e, 0x1c, 0xfa, 0x10, 0x3d, 0x56, 0x3d, 0x21, 0x0e, 0x15, 0x4a, 0x0e, 0xbc, 0x06,
+	0x8a, 0xff, 0x04, 0x2a, 0x6e, 0x5c, 0x2c, 0xcf, 0x7c, 0x33, 0xf3, 0xfb, 0x76, 0x34, 0x10, 0x7b,
+	0xd2, 0x99, 0xc6, 0x21, 0x99, 0xed, 0x12, 0xe5, 0x4c, 0x04, 0x8f, 0x3d, 0x61, 0x85, 0x91, 0xd4,
+	0x12, 0x95, 0x53, 0xc5, 0x9a, 0xed, 0xd6, 0x6b, 0x63, 0x39, 0x96, 0x49, 0x96, 0x2c, 0xff, 0xd2,
+	0x82, 0xfa, 0x23, 0x47, 0x2a, 0x5f, 0xaa, 0x51, 0x2a, 0xa4, 0x41, 0x26, 0xdd, 0x65, 0xbe, 0x1b,
+	0x48, 0x92, 0x7c, 0xd3, 0xd4, 0xd6, 0x07, 0x00, 0xef, 0x50, 0xe1, 0x09, 0xa6, 0xc4, 0x20, 0x03,
+	0xa1, 0x27, 0x10, 0x3a, 0x9e, 0x7b, 0x72, 0x32, 0xe2, 0x4c, 0x0b, 0x0c, 0x1a, 0xa0, 0x59, 0xa6,
+	0xe5, 0x24, 0xb3, 0xcf, 0xb4, 0x40, 0xcf, 0xe1, 0x7a, 0x94, 0x76, 0x8c, 0xf4, 0x69, 0x28, 0x70,
+	0xa1, 0x01, 0x9a, 0x1b, 0xad, 0x07, 0xd6, 0xca, 0x98, 0x95, 0x0d, 0x1c, 0x9e, 0x86, 0x82, 0x56,
+	0xa2, 0x3f, 0x01, 0xc2, 0xb0, 0x14, 0x8a, 0xc8, 0x95, 0x5c, 0xe1, 0x1b, 0x0d, 0xd0, 0xbc, 0x4d,
+	0xf3, 0x70, 0xeb, 0x4b, 0x01, 0xae, 0xf7, 0xa4, 0x33, 0x5d, 0x99, 0xd8, 0x80, 0x05, 0x97, 0x27,
+	0xf0, 0x22, 0x2d, 0xb8, 0x1c, 0xb5, 0x60, 0x89, 0x71, 0x1e, 0x09, 0xa5, 0x12, 0x60, 0xb9, 0x83,
+	0x2f, 0xcf, 0x76, 0x6a, 0xd9, 0xf3, 0xda, 0xa9, 0x32, 0xd0, 0x91, 0x1b, 0x8c, 0x69, 0x5e, 0x88,
+	0x5e, 0xc0, 0x5b, 0xf9, 0xf6, 0x12, 0x5e, 0xa5, 0x55, 0xff, 0xd7, 0x65, 0x4e, 0xec, 0x14, 0xcf,
+	0xaf, 0x36, 0x0d, 0xba, 0xea, 0x40, 0x07, 0x70, 0x8d, 0xf9, 0x32, 0x0e, 0x34, 0x2e, 0x26, 0xc0,
+	0xa7, 0x4b, 0xfd, 0xc7, 0xd5, 0xe6, 0xfd, 0x14, 0xaa, 0xf8, 0xd4, 0x72, 0x25, 0xf1, 0x99, 0x9e,
+	0x58, 0xdd, 0x40, 0x5f, 0x9e, 0xed, 0xc0, 0xcc, 0x4d, 0x37, 0xd0, 0x5f, 0x7f, 0x7d, 0xdb, 0x06,
+	0x34, 0xeb, 0x47, 0xaf, 0x60, 0x49, 0x79, 0x4c, 0x4d, 0x04, 0xc7, 0x37, 0xff, 0x73, 0x54, 0x3e,
+	0x60, 0x9b, 0xc3, 0xca, 0x5f, 0xeb, 0x45, 0x8f, 0x21, 0xa6, 0x76, 0xcf, 0x6e, 0x0f, 0xec, 0xd1,
+	0xf0, 0xe8, 0xd0, 0x1e, 0xbd, 0xed, 0x0f, 0x0e, 0xed, 0xbd, 0xee, 0xcb, 0xae, 0xbd, 0x5f, 0x35,
+	0xd0, 0x43, 0x78, 0xef, 0x9a, 0xda, 0xeb, 0xf6, 0xed, 0x36, 0xad, 0x02, 0x84, 0x61, 0xed, 0x9a,
+	0xf0, 0xfa, 0x4d, 0x7f, 0x78, 0xd0, 0x3b, 0xaa, 0x16, 0xea, 0xc5, 0x8f, 0x9f, 0x4d, 0xa3, 0xd3,
+	0x3b, 0x9f, 0x9b, 0xe0, 0x62, 0x6e, 0x82, 0x9f, 0x73, 0x13, 0x7c, 0x5a, 0x98, 0xc6, 0xc5, 0xc2,
+	0x34, 0xbe, 0x2f, 0x4c, 0xe3, 0x5d, 0x6b, 0xec, 0xea, 0x49, 0x7c, 0x6c, 0x39, 0xd2, 0x27, 0xc3,
+	0x28, 0x56, 0x5a, 0xf0, 0x81, 0xcf, 0x22, 0xbd, 0x37, 0x61, 0x6e, 0x40, 0xb4, 0x72, 0xc8, 0xac,
+	0x45, 0xde, 0x93, 0xec, 0x7e, 0x97, 0x07, 0xa2, 0x8e, 0xd7, 0x92, 0x5b, 0x7b, 0xf6, 0x7b, 0x00,
+	0x0b, 0x80, 0x37, 0x2a, 0xd6, 0x02, 0x00, 0x00,
+}
+
+func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReleaseType != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ReleaseType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CliffDate) > 0 {
+		i -= len(m.CliffDate)
+		copy(dAtA[i:], m.CliffDate)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CliffDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Slashed.Size()
+		i -= size
+		if _, err := m.Slashed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReleaseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CliffDate)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ReleaseType != 0 {
+		n += 1 + sovSchedule(uint64(m.ReleaseType))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovSchedule(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *LockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSchedule(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	l = m.Slashed.Size()
+	n += 1 + l + sovSchedule(uint64(l))
+	return n
+}
+
+func sovSchedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSchedule(x uint64) (n int) {
+	return sovSchedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CliffDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseType", wireType)
+			}
+			m.ReleaseType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseType |= ReleaseType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSchedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSchedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSchedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSchedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSchedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSchedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSchedule = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestLockScheduleLockedAt(t *testing.T) {
+	day := func(month time.Month, d int) time.Time { return time.Date(2026, month, d, 0, 0, 0, 0, time.UTC) }
+
+	monthly := types.LockSchedule{
+		Schedule: types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 3},
+		Amount:   math.NewInt(1000),
+		Slashed:  math.ZeroInt(),
+	}
+	require.NoError(t, monthly.Schedule.Validate())
+	require.Equal(t, day(5, 1), monthly.Schedule.EndTime())
+
+	require.Equal(t, math.NewInt(1000), monthly.LockedAt(day(2, 28)))
+	require.Equal(t, math.NewInt(667), monthly.LockedAt(day(3, 1)))
+	require.Equal(t, math.NewInt(334), monthly.LockedAt(day(4, 30)))
+	// the last step releases the truncation leftover
+	require.Equal(t, math.ZeroInt(), monthly.LockedAt(day(5, 1)))
+
+	releaseTime, amount, found := monthly.NextRelease(day(3, 15))
+	require.True(t, found)
+	require.Equal(t, day(4, 1), releaseTime)
+	require.Equal(t, math.NewInt(333), amount)
+
+	// slashed tokens come off the last steps
+	monthly.Slashed = math.NewInt(400)
+	require.Equal(t, math.NewInt(600), monthly.LockedAt(day(2, 1)))
+	require.Equal(t, math.NewInt(267), monthly.LockedAt(day(3, 1)))
+	require.Equal(t, math.ZeroInt(), monthly.LockedAt(day(4, 1)))
+
+	releaseTime, amount, found = monthly.NextRelease(day(3, 15))
+	require.True(t, found)
+	require.Equal(t, day(4, 1), releaseTime)
+	require.Equal(t, math.NewInt(267), amount)
+
+	_, _, found = monthly.NextRelease(day(4, 1))
+	require.False(t, found)
+
+	linear := types.LockSchedule{
+		Schedule: types.ReleaseSchedule{CliffDate: "2026-03-01T12:00:00Z", ReleaseType: types.RELEASE_TYPE_LINEAR, Periods: 10},
+		Amount:   math.NewInt(100),
+		Slashed:  math.ZeroInt(),
+	}
+	require.Equal(t, math.NewInt(100), linear.LockedAt(day(3, 1)))
+	require.Equal(t, math.NewInt(90), linear.LockedAt(day(3, 2)))
+	require.Equal(t, math.NewInt(50), linear.LockedAt(time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)))
+	require.Equal(t, math.ZeroInt(), linear.LockedAt(day(4, 1)))
+}
+
+func TestReleaseScheduleValidate(t *testing.T) {
+	require.Error(t, types.ReleaseSchedule{CliffDate: "soon", ReleaseType: types.RELEASE_TYPE_LINEAR, Periods: 1}.Validate())
+	require.Error(t, types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_UNSPECIFIED, Periods: 1}.Validate())
+	require.Error(t, types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 0}.Validate())
+	require.Error(t, types.ReleaseSchedule{CliffDate: "2026-03-01", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: types.MaxReleasePeriods + 1}.Validate())
+	require.NoError(t, types.ReleaseSchedule{CliffDate: "1772366400", ReleaseType: types.RELEASE_TYPE_MONTHLY, Periods: 1}.Validate())
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Unlock calendar bucket sizes, in UTC.
+const (
+	UnlockBucketDay   = "day"
+	UnlockBucketWeek  = "week"
+	UnlockBucketMonth = "month"
+)
+
+// ValidateUnlockBucket checks that bucket is a known bucket size.
+func ValidateUnlockBucket(bucket string) error {
+	switch bucket {
+	case UnlockBucketDay, UnlockBucketWeek, UnlockBucketMonth:
+		return nil
+	default:
+		return fmt.Errorf("unknown bucket %q, expected %s, %s or %s", bucket, UnlockBucketDay, UnlockBucketWeek, UnlockBucketMonth)
+	}
+}
+
+// UnlockBucketStart returns the start of the bucket t falls in. Weeks start on
+// Monday. The bucket must be valid.
+func UnlockBucketStart(bucket string, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch bucket {
+	case UnlockBucketWeek:
+		// time.Sunday is 0
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case UnlockBucketMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// UnlockBucketEnd returns the end of the bucket starting at start. The bucket
+// must be valid.
+func UnlockBucketEnd(bucket string, start time.Time) time.Time {
+	switch bucket {
+	case UnlockBucketWeek:
+		return start.AddDate(0, 0, 7)
+	case UnlockBucketMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/TrustedSmartChain/tsc/v2/x/lockup/types"
+)
+
+func TestUnlockBuckets(t *testing.T) {
+	// a Thursday
+	unlockTime := time.Date(2026, 1, 15, 15, 30, 0, 0, time.UTC)
+
+	start := types.UnlockBucketStart(types.UnlockBucketDay, unlockTime)
+	require.Equal(t, time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC), types.UnlockBucketEnd(types.UnlockBucketDay, start))
+
+	start = types.UnlockBucketStart(types.UnlockBucketWeek, unlockTime)
+	require.Equal(t, time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC), types.UnlockBucketEnd(types.UnlockBucketWeek, start))
+
+	// weeks start on Monday, also for Sundays
+	sunday := time.Date(2026, 1, 18, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC), types.UnlockBucketStart(types.UnlockBucketWeek, sunday))
+
+	start = types.UnlockBucketStart(types.UnlockBucketMonth, unlockTime)
+	require.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), types.UnlockBucketEnd(types.UnlockBucketMonth, start))
+
+	require.NoError(t, types.ValidateUnlockBucket(types.UnlockBucketWeek))
+	require.Error(t, types.ValidateUnlockBucket("year"))
+}